@@ -0,0 +1,294 @@
+// Package modelcheck defines an Analyzer that reports misuses of GopherJS
+// model structs, the structs embeding `*js.Object` whose `js struct tag`
+// fields are bound to VueJS.
+//
+// Reported problems:
+//
+//  * structs passed to `vue.New` or `Option.SetDataWithMethods` without
+//  an embeded `*js.Object`, they can't have bidirectional data bindings.
+//
+//  * exported fields of such structs without the `js struct tag`, they are
+//  not visible to VueJS. Unexported fields like a `sync.Mutex` are never
+//  bound thus not reported.
+//
+//  * `append` on slice fields of model structs, it copies the observed
+//  array into a new one each time, use `vue.ReactiveSlice` instead.
+//
+//  * model structs built by composite literals or `new` without
+//  initializing the embeded `Object`, accessing any field panics. The ones
+//  passed to `vue.NewModel` directly are initialized by it thus ignored.
+package modelcheck
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	vuePath = "github.com/oskca/gopherjs-vue"
+	jsPath  = "github.com/gopherjs/gopherjs/js"
+)
+
+const doc = `check GopherJS model structs used with gopherjs-vue
+
+The modelcheck analyzer reports model structs without an embeded *js.Object
+or js struct tags on exported fields, append on reactive slice fields and model structs
+created without initializing the embeded Object.`
+
+// Analyzer reports misuses of GopherJS model structs
+var Analyzer = &analysis.Analyzer{
+	Name:     "modelcheck",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodes := []ast.Node{
+		(*ast.CallExpr)(nil),
+		(*ast.CompositeLit)(nil),
+	}
+	initialized := make(map[ast.Node]bool)
+	ins.Preorder(nodes, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if isNewModel(pass, n) {
+				for _, arg := range n.Args {
+					arg = ast.Unparen(arg)
+					if u, ok := arg.(*ast.UnaryExpr); ok {
+						arg = ast.Unparen(u.X)
+					}
+					initialized[arg] = true
+				}
+			}
+			if !initialized[n] {
+				checkCall(pass, n)
+			}
+		case *ast.CompositeLit:
+			if !initialized[n] {
+				checkCompositeLit(pass, n)
+			}
+		}
+	})
+	return nil, nil
+}
+
+// isNewModel reports whether call is `vue.NewModel(...)`
+func isNewModel(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := callee(pass.TypesInfo, call).(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == vuePath &&
+		fn.Name() == "NewModel" && isFunc(fn)
+}
+
+func checkCall(pass *analysis.Pass, call *ast.CallExpr) {
+	switch fn := callee(pass.TypesInfo, call).(type) {
+	case *types.Builtin:
+		switch fn.Name() {
+		case "append":
+			checkAppend(pass, call)
+		case "new":
+			if len(call.Args) == 1 {
+				if st := modelStruct(pass.TypesInfo.TypeOf(call.Args[0])); st != nil && hasTaggedField(st) {
+					pass.Reportf(call.Pos(), "%s created by new has nil Object, initialize it with vue.NewModel",
+						typeName(pass, call.Args[0]))
+				}
+			}
+		}
+	case *types.Func:
+		if fn.Pkg() == nil || fn.Pkg().Path() != vuePath {
+			return
+		}
+		switch {
+		case fn.Name() == "New" && isFunc(fn) && len(call.Args) == 2:
+			checkModel(pass, call.Args[1], "vue.New")
+		case fn.Name() == "SetDataWithMethods" && isMethodOf(fn, "Option") && len(call.Args) == 1:
+			checkModel(pass, call.Args[0], "SetDataWithMethods")
+		}
+	}
+}
+
+// checkModel checks the struct pointer passed to `callee` as data
+func checkModel(pass *analysis.Pass, arg ast.Expr, callee string) {
+	t := pass.TypesInfo.TypeOf(arg)
+	ptr, ok := t.Underlying().(*types.Pointer)
+	if !ok {
+		return
+	}
+	st, ok := ptr.Elem().Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	name := types.TypeString(ptr.Elem(), types.RelativeTo(pass.Pkg))
+	if objectField(st) < 0 {
+		pass.Reportf(arg.Pos(), "%s passed to %s has no embeded *js.Object, its fields can't be bound to VueJS",
+			name, callee)
+		return
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if f.Embedded() || !f.Exported() {
+			continue
+		}
+		if _, ok := reflect.StructTag(st.Tag(i)).Lookup("js"); !ok {
+			pass.Reportf(arg.Pos(), "field %s of %s passed to %s has no js tag, it's invisible to VueJS",
+				f.Name(), name, callee)
+		}
+	}
+}
+
+// checkAppend reports `append` whose first argument is a reactive field
+func checkAppend(pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) == 0 {
+		return
+	}
+	sel, ok := ast.Unparen(call.Args[0]).(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return
+	}
+	owner, tag := fieldOwner(selection)
+	if owner == nil || objectField(owner) < 0 || tag == "" {
+		return
+	}
+	pass.Reportf(call.Pos(), "append on reactive slice field %s copies the observed array, use vue.ReactiveSlice instead",
+		sel.Sel.Name)
+}
+
+// checkCompositeLit reports model struct literals without Object
+func checkCompositeLit(pass *analysis.Pass, lit *ast.CompositeLit) {
+	st := modelStruct(pass.TypesInfo.TypeOf(lit))
+	if st == nil || !hasTaggedField(st) {
+		return
+	}
+	if len(lit.Elts) > 0 {
+		if _, keyed := lit.Elts[0].(*ast.KeyValueExpr); !keyed {
+			// positional literals set every field
+			return
+		}
+	}
+	for _, elt := range lit.Elts {
+		kv := elt.(*ast.KeyValueExpr)
+		if id, ok := kv.Key.(*ast.Ident); ok && id.Name == "Object" {
+			return
+		}
+	}
+	pass.Reportf(lit.Pos(), "%s literal has nil Object, initialize it with vue.NewModel",
+		types.TypeString(pass.TypesInfo.TypeOf(lit), types.RelativeTo(pass.Pkg)))
+}
+
+// callee returns the func or builtin called by call
+func callee(info *types.Info, call *ast.CallExpr) types.Object {
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		return info.Uses[fun]
+	case *ast.SelectorExpr:
+		if sel, ok := info.Selections[fun]; ok {
+			return sel.Obj()
+		}
+		return info.Uses[fun.Sel]
+	}
+	return nil
+}
+
+func isFunc(fn *types.Func) bool {
+	return fn.Type().(*types.Signature).Recv() == nil
+}
+
+// isMethodOf reports whether fn is a method of the vue type `name`
+func isMethodOf(fn *types.Func, name string) bool {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return false
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == name
+}
+
+// modelStruct returns the struct of t if it embeds *js.Object
+func modelStruct(t types.Type) *types.Struct {
+	if t == nil {
+		return nil
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok || objectField(st) < 0 {
+		return nil
+	}
+	return st
+}
+
+// objectField returns the index of the embeded *js.Object or -1
+func objectField(st *types.Struct) int {
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if f.Embedded() && isJSObject(f.Type()) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isJSObject(t types.Type) bool {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Name() == "Object" && obj.Pkg() != nil && obj.Pkg().Path() == jsPath
+}
+
+// hasTaggedField reports whether st has any `js struct tag` field,
+// structs without them are only used as method holders.
+func hasTaggedField(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if reflect.StructTag(st.Tag(i)).Get("js") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldOwner returns the struct declaring the selected field and the
+// `js struct tag` of the field if it's a slice
+func fieldOwner(sel *types.Selection) (*types.Struct, string) {
+	t := sel.Recv()
+	index := sel.Index()
+	for i, idx := range index {
+		if ptr, ok := t.Underlying().(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return nil, ""
+		}
+		f := st.Field(idx)
+		if i == len(index)-1 {
+			if _, ok := f.Type().Underlying().(*types.Slice); !ok {
+				return nil, ""
+			}
+			return st, reflect.StructTag(st.Tag(idx)).Get("js")
+		}
+		t = f.Type()
+	}
+	return nil, ""
+}
+
+func typeName(pass *analysis.Pass, expr ast.Expr) string {
+	return types.TypeString(pass.TypesInfo.TypeOf(expr), types.RelativeTo(pass.Pkg))
+}
@@ -0,0 +1,12 @@
+package modelcheck_test
+
+import (
+	"testing"
+
+	"github.com/oskca/gopherjs-vue/analysis/modelcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), modelcheck.Analyzer, "a")
+}
@@ -0,0 +1,42 @@
+package a
+
+import (
+	"sync"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/oskca/gopherjs-vue"
+)
+
+type Plain struct {
+	Name string
+}
+
+type Model struct {
+	*js.Object
+	Items []string `js:"items"`
+	Count int
+	mu    sync.Mutex
+}
+
+type Guarded struct {
+	*js.Object
+	Name string `js:"name"`
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+type holder struct{ *js.Object }
+
+func main() {
+	m := &Model{Count: 1}          // want `Model literal has nil Object, initialize it with vue.NewModel`
+	m.Items = append(m.Items, "x") // want `append on reactive slice field Items copies the observed array, use vue.ReactiveSlice instead`
+	vue.New("#a", m)               // want `field Count of Model passed to vue.New has no js tag, it's invisible to VueJS`
+	vue.New("#b", &Plain{})        // want `Plain passed to vue.New has no embeded \*js.Object, its fields can't be bound to VueJS`
+	vue.New("#c", new(holder))
+	vue.New("#d", vue.NewModel(&Guarded{}).(*Guarded))
+	_ = new(Model) // want `Model created by new has nil Object, initialize it with vue.NewModel`
+	_ = vue.NewModel(&Model{})
+	_ = vue.NewModel(new(Model))
+	_ = &Model{Object: js.Global.Get("Object").New()}
+	vue.NewOption().SetDataWithMethods(&Plain{}) // want `Plain passed to SetDataWithMethods has no embeded \*js.Object, its fields can't be bound to VueJS`
+}
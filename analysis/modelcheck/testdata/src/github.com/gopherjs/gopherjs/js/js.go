@@ -0,0 +1,10 @@
+// Package js is a stub of the GopherJS js package for the analyzer tests
+package js
+
+type Object struct{}
+
+func (o *Object) Get(key string) *Object { return nil }
+
+func (o *Object) New(args ...interface{}) *Object { return nil }
+
+var Global *Object
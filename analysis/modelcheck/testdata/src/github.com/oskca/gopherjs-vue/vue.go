@@ -0,0 +1,14 @@
+// Package vue is a stub of gopherjs-vue for the analyzer tests
+package vue
+
+type ViewModel struct{}
+
+type Option struct{}
+
+func New(selectorOrElementOrFunction interface{}, structPtr interface{}) *ViewModel { return nil }
+
+func NewModel(structPtr interface{}) interface{} { return structPtr }
+
+func NewOption() *Option { return nil }
+
+func (o *Option) SetDataWithMethods(structPtr interface{}) *Option { return o }
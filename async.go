@@ -0,0 +1,132 @@
+package vue
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ErrAsyncTimeout is the error of an async component whose factory
+// did not finish within AsyncOptions.Timeout
+var ErrAsyncTimeout = errors.New("vue: async component timeout")
+
+// AsyncOptions configs the placeholders of an async component
+type AsyncOptions struct {
+	// Loading is rendered while the factory is running, optional
+	Loading *Component
+	// Error is rendered when the factory failed or timed out, optional.
+	// The error message is passed in as the `error` prop.
+	Error *Component
+	// Timeout of the factory, no timeout if zero
+	Timeout time.Duration
+}
+
+// asyncComponent holds the state of an async component shared by
+// all its instances
+type asyncComponent struct {
+	factory  func() (*Component, error)
+	opts     AsyncOptions
+	started  bool
+	resolved *Component
+	err      error
+	// status is a reactive VueJS instance thus the parents rendering
+	// the component re-render when the factory finishes
+	status *ViewModel
+}
+
+const (
+	asyncLoading = "loading"
+	asyncReady   = "ready"
+	asyncFailed  = "error"
+)
+
+func (a *asyncComponent) start() {
+	a.started = true
+	type result struct {
+		c   *Component
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		c, err := a.factory()
+		done <- result{c, err}
+	}()
+	go func() {
+		var timeout <-chan time.Time
+		if a.opts.Timeout > 0 {
+			timeout = time.After(a.opts.Timeout)
+		}
+		select {
+		case r := <-done:
+			a.resolve(r.c, r.err)
+		case <-timeout:
+			a.resolve(nil, ErrAsyncTimeout)
+		}
+	}()
+}
+
+func (a *asyncComponent) resolve(c *Component, err error) {
+	if err == nil && c == nil {
+		err = errors.New("vue: async component factory returned nil")
+	}
+	if err != nil {
+		a.err = err
+		a.status.Object.Set("status", asyncFailed)
+		return
+	}
+	a.resolved = c
+	a.status.Object.Set("status", asyncReady)
+}
+
+// render is the render function of the functional wrapper component
+func (a *asyncComponent) render(h, context *js.Object) interface{} {
+	if !a.started {
+		a.start()
+	}
+	// reading status here makes the parent depend on it
+	switch a.status.Get("status").String() {
+	case asyncReady:
+		return h.Invoke(a.resolved.Object, context.Get("data"), context.Get("children"))
+	case asyncFailed:
+		if a.opts.Error != nil {
+			return h.Invoke(a.opts.Error.Object, js.M{
+				"props": js.M{"error": a.err.Error()},
+			})
+		}
+	default:
+		if a.opts.Loading != nil {
+			return h.Invoke(a.opts.Loading.Object)
+		}
+	}
+	// empty vnode
+	return h.Invoke()
+}
+
+// RegisterAsync registers a named global Component whose definition is
+// created lazily by `factory` in a goroutine, thus `factory` may block to
+// fetch or compute heavy components. The factory is called only once when
+// the component is rendered the first time.
+//
+// The optional AsyncOptions configs the loading and error placeholders and
+// the timeout of `factory`. All attributes, listeners and children are passed
+// through to the resolved component.
+func RegisterAsync(name string, factory func() (*Component, error), opts ...AsyncOptions) *Component {
+	a := &asyncComponent{
+		factory: factory,
+	}
+	if len(opts) > 0 {
+		a.opts = opts[0]
+	}
+	status := NewOption()
+	status.Data = js.M{"status": asyncLoading}
+	a.status = status.NewViewModel()
+
+	opt := NewOption()
+	opt.Name = name
+	opt.Set("functional", true)
+	opt.Set("render", func(h, context *js.Object) interface{} {
+		return a.render(h, context)
+	})
+	return opt.NewComponent().Register(name)
+}
@@ -0,0 +1,90 @@
+package vue
+
+import (
+	"sync"
+)
+
+// batch holds the mutations queued by Batch
+var batch struct {
+	sync.Mutex
+	fns       []func()
+	done      []chan struct{}
+	scheduled bool
+}
+
+// Batch queues `fn` which mutates the data of VueJS instances, all queued
+// mutations are applied together in order before the next render, so
+// goroutines resuming from blocking calls never interleave with
+// the render cycle of VueJS.
+//
+// `fn` runs in a JavaScript callback thus must not block. The returned
+// channel is closed after the mutations are applied and the DOM is updated.
+func Batch(fn func()) <-chan struct{} {
+	done := make(chan struct{})
+	batch.Lock()
+	batch.fns = append(batch.fns, fn)
+	batch.done = append(batch.done, done)
+	if !batch.scheduled {
+		batch.scheduled = true
+		NextTick(flushBatch)
+	}
+	batch.Unlock()
+	return done
+}
+
+func flushBatch() {
+	batch.Lock()
+	fns, done := batch.fns, batch.done
+	batch.fns, batch.done = nil, nil
+	batch.scheduled = false
+	batch.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+	// the re-render caused by fns is scheduled before this
+	NextTick(func() {
+		for _, ch := range done {
+			close(ch)
+		}
+	})
+}
+
+// Update is like Batch but `fn` is skipped if the instance is destroyed
+// before the mutations are applied:
+//
+//	go func() {
+//		resp, err := http.Get(url)
+//		...
+//		<-vm.Update(func() {
+//			m.Result = string(body)
+//		})
+//		// the DOM is updated now
+//	}()
+func (v *ViewModel) Update(fn func()) <-chan struct{} {
+	return Batch(func() {
+		if v.Get("_isDestroyed").Bool() {
+			return
+		}
+		fn()
+	})
+}
+
+// NextTickChan returns a channel which is closed after the next DOM update
+// cycle, it's the channel version of NextTick.
+func NextTickChan() <-chan struct{} {
+	ch := make(chan struct{})
+	NextTick(func() {
+		close(ch)
+	})
+	return ch
+}
+
+// NextTickChan returns a channel which is closed after the next DOM update
+// cycle, it's the channel version of vm.$nextTick.
+func (v *ViewModel) NextTickChan() <-chan struct{} {
+	ch := make(chan struct{})
+	v.Call("$nextTick", func() {
+		close(ch)
+	})
+	return ch
+}
@@ -0,0 +1,636 @@
+// Command vue-check statically checks the templates of a gopherjs-vue
+// package against the Go structs bound to them, so typos like
+// `{{ integr }}` or `@click="Repet"` are found before running in browsers.
+//
+// Templates passed to `NewComponent`, assigned to `Option.Template`, and the
+// elements of `.html` files in the package directory mounted by `New` or
+// `Option.El` are checked. Identifiers are resolved against the
+// `js struct tag` fields and exported methods of the struct bound by
+// `New`, `NewComponent`, `Option.SetDataWithMethods` and
+// `Option.SetDataFactory`, plus the names added by `Option.AddProp`,
+// `Option.AddTypedProp`, `Option.AddComputed`, `Option.AddMethod` and the
+// `MapState`, `MapGetters` and `MapMutations` helpers of the store package.
+// Filters and components must be registered somewhere in the package.
+//
+// Usage:
+//
+//	vue-check [package dir]
+//
+// Imported packages are type checked from source thus must be found in the
+// GOPATH, templates whose model type can't be resolved are reported instead
+// of being skipped. Problems are reported with file:line positions, the
+// exit code is 1 if there are any.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	vuePath = "github.com/oskca/gopherjs-vue"
+	jsPath  = "github.com/gopherjs/gopherjs/js"
+)
+
+func main() {
+	flag.Parse()
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	problems, err := checkDir(dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "vue-check:", err)
+		os.Exit(2)
+	}
+	for _, p := range problems {
+		fmt.Printf("%s: %s\n", p.pos, p.msg)
+	}
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+}
+
+// source is a template with its position
+type source struct {
+	file string
+	line int
+	src  string
+}
+
+// binding is a template bound to a model
+type binding struct {
+	model types.Type // the struct pointer type, nil if unknown
+	// unresolved is set when the model is given but its type is invalid
+	unresolved bool
+	// allGetters is set by MapGetters without names which maps all getters
+	allGetters bool
+	names      *names
+	template   *source
+	el         string // `#id` selector of the mount point
+	pos        token.Position
+}
+
+// setModel sets the model type, invalid types mark the binding unresolved
+func (b *binding) setModel(t types.Type) {
+	b.model, b.unresolved = t, false
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if t == nil || t == types.Typ[types.Invalid] {
+		b.model, b.unresolved = nil, true
+	}
+}
+
+// pkg is the loaded package
+type pkg struct {
+	dir        string
+	fset       *token.FileSet
+	files      []*ast.File
+	info       *types.Info
+	typeErrs   []error
+	consts     map[types.Object]*ast.BasicLit
+	filters    map[string]bool
+	components map[string]bool
+	getters    map[string]bool // store getters
+	options    map[types.Object]*binding
+	bindings   []*binding
+}
+
+func checkDir(dir string) ([]problem, error) {
+	p := &pkg{
+		dir:        dir,
+		fset:       token.NewFileSet(),
+		consts:     make(map[types.Object]*ast.BasicLit),
+		filters:    make(map[string]bool),
+		components: make(map[string]bool),
+		getters:    make(map[string]bool),
+		options:    make(map[types.Object]*binding),
+	}
+	pkgs, err := parser.ParseDir(p.fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	var problems []problem
+	for _, ap := range pkgs {
+		p.files = p.files[:0]
+		for _, f := range ap.Files {
+			p.files = append(p.files, f)
+		}
+		sort.Slice(p.files, func(i, j int) bool {
+			return p.fset.File(p.files[i].Pos()).Name() < p.fset.File(p.files[j].Pos()).Name()
+		})
+		p.info = &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Defs:  make(map[*ast.Ident]types.Object),
+			Uses:  make(map[*ast.Ident]types.Object),
+		}
+		// imports are type checked from source since gopherjs packages
+		// have no compiled export data, errors are kept to explain the
+		// bindings whose model type can't be resolved
+		p.typeErrs = nil
+		conf := types.Config{
+			Importer: importer.ForCompiler(p.fset, "source", nil),
+			Error: func(err error) {
+				p.typeErrs = append(p.typeErrs, err)
+			},
+		}
+		conf.Check(ap.Name, p.fset, p.files, p.info)
+		p.collect()
+		for _, b := range p.bindings {
+			problems = append(problems, p.check(b)...)
+		}
+	}
+	return problems, nil
+}
+
+// vueName returns the local name of the gopherjs-vue import of f
+func vueName(f *ast.File) string {
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path == vuePath {
+			if imp.Name != nil {
+				return imp.Name.Name
+			}
+			return "vue"
+		}
+	}
+	return ""
+}
+
+// isVueCall reports whether call is `vue.<name>(...)`
+func isVueCall(call *ast.CallExpr, vue, name string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == vue
+}
+
+// methodCall returns the receiver and name of `x.name(...)`
+func methodCall(call *ast.CallExpr) (ast.Expr, string) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, ""
+	}
+	return sel.X, sel.Sel.Name
+}
+
+// rootCall returns the name of the innermost call of a call chain like
+// `vue.NewComponent(...).Register(...)`
+func rootCall(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.CallExpr:
+			if sel, ok := e.Fun.(*ast.SelectorExpr); ok {
+				if inner, ok := sel.X.(*ast.CallExpr); ok {
+					expr = inner
+					continue
+				}
+				return sel.Sel.Name
+			}
+			if id, ok := e.Fun.(*ast.Ident); ok {
+				return id.Name
+			}
+			return ""
+		case *ast.SelectorExpr:
+			expr = e.X
+		default:
+			return ""
+		}
+	}
+}
+
+func (p *pkg) stringValue(expr ast.Expr) (string, bool) {
+	tv, ok := p.info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// sourceOf returns the template string of expr with its position
+func (p *pkg) sourceOf(expr ast.Expr) *source {
+	src, ok := p.stringValue(expr)
+	if !ok {
+		return nil
+	}
+	lit, _ := expr.(*ast.BasicLit)
+	if id, ok := expr.(*ast.Ident); ok {
+		lit = p.consts[p.info.Uses[id]]
+	}
+	pos := p.fset.Position(expr.Pos())
+	s := &source{file: pos.Filename, line: pos.Line, src: src}
+	if lit != nil && strings.HasPrefix(lit.Value, "`") {
+		// lines of raw strings map to the source lines
+		pos = p.fset.Position(lit.Pos())
+		s.file, s.line = pos.Filename, pos.Line
+	}
+	return s
+}
+
+// option returns the binding of Option variable expr
+func (p *pkg) option(expr ast.Expr) *binding {
+	id, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := p.info.Uses[id]
+	if obj == nil {
+		obj = p.info.Defs[id]
+	}
+	if obj == nil {
+		return nil
+	}
+	b, ok := p.options[obj]
+	if !ok {
+		b = &binding{names: newNames(), pos: p.fset.Position(id.Pos())}
+		p.options[obj] = b
+		p.bindings = append(p.bindings, b)
+	}
+	return b
+}
+
+func (p *pkg) collect() {
+	p.bindings = nil
+	p.options = make(map[types.Object]*binding)
+	// constants declared by raw string literals
+	for _, f := range p.files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok {
+				return true
+			}
+			for i, name := range spec.Names {
+				if i < len(spec.Values) {
+					if lit, ok := spec.Values[i].(*ast.BasicLit); ok {
+						p.consts[p.info.Defs[name]] = lit
+					}
+				}
+			}
+			return true
+		})
+	}
+	for _, f := range p.files {
+		vue := vueName(f)
+		if vue == "" {
+			continue
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CallExpr:
+				p.collectCall(n, vue)
+			case *ast.AssignStmt:
+				for i, lhs := range n.Lhs {
+					sel, ok := lhs.(*ast.SelectorExpr)
+					if !ok || i >= len(n.Rhs) {
+						continue
+					}
+					switch sel.Sel.Name {
+					case "Template":
+						if b := p.option(sel.X); b != nil {
+							b.template = p.sourceOf(n.Rhs[i])
+						}
+					case "El":
+						if b := p.option(sel.X); b != nil {
+							b.el, _ = p.stringValue(n.Rhs[i])
+						}
+					case "Data":
+						if b := p.option(sel.X); b != nil {
+							b.setModel(p.info.TypeOf(n.Rhs[i]))
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+func (p *pkg) collectCall(call *ast.CallExpr, vue string) {
+	switch {
+	case isVueCall(call, vue, "New") && len(call.Args) == 2:
+		b := &binding{
+			names: newNames(),
+			pos:   p.fset.Position(call.Pos()),
+		}
+		b.setModel(p.info.TypeOf(call.Args[1]))
+		b.el, _ = p.stringValue(call.Args[0])
+		p.bindings = append(p.bindings, b)
+		return
+	case isVueCall(call, vue, "NewComponent") && len(call.Args) >= 2:
+		b := &binding{
+			names:    newNames(),
+			template: p.sourceOf(call.Args[1]),
+			pos:      p.fset.Position(call.Pos()),
+		}
+		b.setModel(p.creatorType(call.Args[0]))
+		p.bindings = append(p.bindings, b)
+		return
+	case isVueCall(call, vue, "RegisterAsync") && len(call.Args) > 0:
+		if name, ok := p.stringValue(call.Args[0]); ok {
+			p.components[kebab(name)] = true
+		}
+		return
+	}
+	recv, name := methodCall(call)
+	if recv == nil {
+		return
+	}
+	args := call.Args
+	switch name {
+	case "Register":
+		if len(args) == 0 {
+			return
+		}
+		n, ok := p.stringValue(args[0])
+		if !ok {
+			return
+		}
+		switch rootCall(recv) {
+		case "NewFilter", "Filter":
+			p.filters[n] = true
+		case "NewDirective":
+		case "":
+			// unknown receiver, could be either
+			p.filters[n] = true
+			p.components[kebab(n)] = true
+		default:
+			p.components[kebab(n)] = true
+		}
+	case "AddSubComponent":
+		if len(args) > 0 {
+			if n, ok := p.stringValue(args[0]); ok {
+				p.components[kebab(n)] = true
+			}
+		}
+	case "SetDataWithMethods":
+		if b := p.option(recv); b != nil && len(args) > 0 {
+			b.setModel(p.info.TypeOf(args[0]))
+		}
+	case "SetDataFactory":
+		if b := p.option(recv); b != nil && len(args) > 0 {
+			b.setModel(p.creatorType(args[0]))
+		}
+	case "AddProp":
+		if b := p.option(recv); b != nil {
+			for _, a := range args {
+				if n, ok := p.stringValue(a); ok {
+					b.names.fields[camelize(n)] = true
+				}
+			}
+		}
+	case "AddTypedProp":
+		if b := p.option(recv); b != nil {
+			for _, a := range args {
+				if n, ok := p.propName(a, vue); ok {
+					b.names.fields[camelize(n)] = true
+				}
+			}
+		}
+	case "MapState", "MapGetters", "MapMutations":
+		// store helpers take the option as the first argument
+		if len(args) == 0 {
+			return
+		}
+		b := p.option(args[0])
+		if b == nil {
+			return
+		}
+		if name == "MapGetters" && len(args) == 1 {
+			b.allGetters = true
+		}
+		for _, a := range args[1:] {
+			n, ok := p.stringValue(a)
+			if !ok {
+				continue
+			}
+			if name == "MapMutations" {
+				b.names.methods[n] = true
+			} else {
+				b.names.fields[n] = true
+			}
+		}
+	case "Getter":
+		if len(args) > 0 {
+			if n, ok := p.stringValue(args[0]); ok {
+				p.getters[n] = true
+			}
+		}
+	case "AddComputed":
+		if b := p.option(recv); b != nil && len(args) > 0 {
+			if n, ok := p.stringValue(args[0]); ok {
+				b.names.fields[n] = true
+			}
+		}
+	case "AddMethod", "AddAsyncMethod":
+		if b := p.option(recv); b != nil && len(args) > 0 {
+			if n, ok := p.stringValue(args[0]); ok {
+				b.names.methods[n] = true
+			}
+		}
+	}
+}
+
+// propName returns the name of the prop declared by `vue.NewProp(name)` or
+// a `vue.Prop{Name: name}` literal
+func (p *pkg) propName(expr ast.Expr, vue string) (string, bool) {
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = u.X
+	}
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		if isVueCall(e, vue, "NewProp") && len(e.Args) > 0 {
+			return p.stringValue(e.Args[0])
+		}
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if id, ok := kv.Key.(*ast.Ident); ok && id.Name == "Name" {
+				return p.stringValue(kv.Value)
+			}
+		}
+	}
+	return "", false
+}
+
+// creatorType returns the struct pointer type returned by a creator func
+func (p *pkg) creatorType(expr ast.Expr) types.Type {
+	var body *ast.BlockStmt
+	switch e := expr.(type) {
+	case *ast.FuncLit:
+		body = e.Body
+	case *ast.Ident:
+		obj := p.info.Uses[e]
+		if obj == nil {
+			return nil
+		}
+		for _, f := range p.files {
+			for _, d := range f.Decls {
+				if fd, ok := d.(*ast.FuncDecl); ok && p.info.Defs[fd.Name] == obj {
+					body = fd.Body
+				}
+			}
+		}
+	}
+	if body == nil {
+		return nil
+	}
+	var typ types.Type
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if ret, ok := n.(*ast.ReturnStmt); ok && len(ret.Results) == 1 && typ == nil {
+			typ = p.info.TypeOf(ret.Results[0])
+		}
+		return true
+	})
+	return typ
+}
+
+// modelNames collects the `js struct tag` fields and exported methods
+func modelNames(t types.Type, n *names) (string, bool) {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return "", false
+	}
+	st, ok := ptr.Elem().Underlying().(*types.Struct)
+	if !ok {
+		return "", false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		tag := reflect.StructTag(st.Tag(i)).Get("js")
+		if tag != "" && tag != "-" {
+			n.fields[strings.Split(tag, ",")[0]] = true
+		}
+	}
+	ms := types.NewMethodSet(ptr)
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i).Obj()
+		if !m.Exported() || m.Pkg() != nil && m.Pkg().Path() == jsPath {
+			continue
+		}
+		n.methods[m.Name()] = true
+	}
+	return types.TypeString(ptr.Elem(), func(*types.Package) string { return "" }), true
+}
+
+func (p *pkg) check(b *binding) []problem {
+	if b.template == nil && b.el != "" {
+		b.template = p.htmlTemplate(b.el)
+	}
+	if b.template == nil {
+		return nil
+	}
+	if b.unresolved {
+		msg := "the model type can't be resolved, the template is not checked"
+		if len(p.typeErrs) > 0 {
+			msg += ": " + p.typeErrs[0].Error()
+		}
+		return []problem{{
+			pos: fmt.Sprintf("%s:%d", b.pos.Filename, b.pos.Line),
+			msg: msg,
+		}}
+	}
+	if b.allGetters {
+		for g := range p.getters {
+			b.names.fields[g] = true
+		}
+	}
+	model := "the template"
+	if b.model != nil {
+		name, ok := modelNames(b.model, b.names)
+		if !ok {
+			// data is not a struct pointer, identifiers can't be resolved
+			return nil
+		}
+		model = name
+	} else if len(b.names.fields)+len(b.names.methods) == 0 {
+		return nil
+	}
+	c := &checker{
+		names:      b.names,
+		filters:    p.filters,
+		components: p.components,
+		model:      model,
+		file:       b.template.file,
+		line:       b.template.line,
+		src:        b.template.src,
+	}
+	c.check()
+	return c.problems
+}
+
+// htmlTemplate finds the element with `#id` in the html files of the package
+func (p *pkg) htmlTemplate(sel string) *source {
+	if !strings.HasPrefix(sel, "#") {
+		return nil
+	}
+	files, _ := filepath.Glob(filepath.Join(p.dir, "*.html"))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		html := string(data)
+		for _, q := range []string{`id="` + sel[1:] + `"`, `id='` + sel[1:] + `'`} {
+			at := strings.Index(html, q)
+			if at < 0 {
+				continue
+			}
+			start := strings.LastIndexByte(html[:at], '<')
+			end := elementEnd(html, start)
+			return &source{
+				file: file,
+				line: 1 + strings.Count(html[:start], "\n"),
+				src:  html[start:end],
+			}
+		}
+	}
+	return nil
+}
+
+// elementEnd returns the end offset of the element starting at `start`
+func elementEnd(html string, start int) int {
+	i := start + 1
+	for i < len(html) && isIdentChar(html[i]) || i < len(html) && html[i] == '-' {
+		i++
+	}
+	tag := html[start+1 : i]
+	depth := 0
+	for k := start; k < len(html); k++ {
+		switch {
+		case strings.HasPrefix(html[k:], "</"+tag):
+			depth--
+			if depth == 0 {
+				if e := strings.IndexByte(html[k:], '>'); e >= 0 {
+					return k + e + 1
+				}
+				return len(html)
+			}
+		case strings.HasPrefix(html[k:], "<"+tag) && k+len(tag)+1 < len(html) &&
+			!isIdentChar(html[k+len(tag)+1]) && html[k+len(tag)+1] != '-':
+			depth++
+		}
+	}
+	return len(html)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePackage(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "vue-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestCheckDirUnresolvedModel(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"main.go": `package main
+
+import "github.com/oskca/gopherjs-vue"
+
+func main() {
+	vue.New("#app", newModel())
+}
+`,
+		"index.html": `<div id="app">{{ anything }}</div>`,
+	})
+	defer os.RemoveAll(dir)
+	problems, err := checkDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 1 {
+		t.Fatalf("expect 1 problem, got %v", problems)
+	}
+	p := problems[0]
+	if !strings.HasSuffix(p.pos, "main.go:6") || !strings.Contains(p.msg, "can't be resolved") {
+		t.Errorf("unexpected problem %v", p)
+	}
+}
+
+func TestCheckDirWithoutVue(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"main.go": "package main\n\nfunc main() {}\n",
+	})
+	defer os.RemoveAll(dir)
+	problems, err := checkDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 0 {
+		t.Errorf("expect no problem, got %v", problems)
+	}
+}
+
+func TestCheckDirDeclaredNames(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"main.go": `package main
+
+import (
+	"github.com/oskca/gopherjs-vue"
+	"github.com/oskca/gopherjs-vue/store"
+)
+
+const tpl = ` + "`" + `<div>
+	{{ title }} {{ maxCount }} {{ limit }} {{ size }}
+	{{ total }} {{ double }}
+	<button @click="inc(1)">{{ missing }}</button>
+</div>` + "`" + `
+
+func main() {
+	s := store.New(nil)
+	s.Getter("half", nil).Getter("double", nil)
+	opt := vue.NewOption()
+	opt.Template = tpl
+	opt.AddTypedProp(vue.NewProp("title", vue.PropString), vue.NewProp("max-count"))
+	opt.AddTypedProp(&vue.Prop{Name: "limit"}, &vue.Prop{Name: "size"})
+	s.MapState(opt, "total")
+	s.MapGetters(opt, "double")
+	s.MapMutations(opt, "inc")
+	opt.NewComponent().Register("counter")
+
+	all := vue.NewOption()
+	all.Template = "<p>{{ half }} {{ double }} {{ other }}</p>"
+	s.MapGetters(all)
+	all.NewComponent().Register("getters")
+}
+`,
+	})
+	defer os.RemoveAll(dir)
+	problems, err := checkDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.msg)
+	}
+	want := []string{
+		`unknown field or method "missing" of the template`,
+		`unknown field or method "other" of the template`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got problems\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
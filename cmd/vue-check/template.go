@@ -0,0 +1,484 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// names are the identifiers a template can use
+type names struct {
+	// fields are data, props and computed properties
+	fields map[string]bool
+	// methods are the methods of the VueJS instance
+	methods map[string]bool
+}
+
+func newNames() *names {
+	return &names{
+		fields:  make(map[string]bool),
+		methods: make(map[string]bool),
+	}
+}
+
+func (n *names) has(name string) bool {
+	return n.fields[name] || n.methods[name]
+}
+
+// globals are the identifiers VueJS allows in template expressions
+var globals = toSet(
+	"true false null undefined NaN Infinity this typeof instanceof in of new void delete " +
+		"Math Date JSON Number String Boolean Array Object RegExp parseInt parseFloat " +
+		"isNaN isFinite encodeURI encodeURIComponent decodeURI decodeURIComponent " +
+		"Intl require arguments",
+)
+
+// builtinComponents are always available
+var builtinComponents = toSet(
+	"component transition transition-group keep-alive slot template router-link router-view",
+)
+
+// voidElements have no close tag
+var voidElements = toSet(
+	"area base br col embed hr img input keygen link meta param source track wbr",
+)
+
+func toSet(list string) map[string]bool {
+	set := make(map[string]bool)
+	for _, s := range strings.Fields(list) {
+		set[s] = true
+	}
+	return set
+}
+
+// problem is an issue found in a template
+type problem struct {
+	pos string
+	msg string
+}
+
+// checker checks a template against the names of its model
+type checker struct {
+	names      *names
+	filters    map[string]bool
+	components map[string]bool
+	model      string // name of the model for messages
+	file       string
+	line       int // line of the template start
+	src        string
+	problems   []problem
+}
+
+func (c *checker) report(offset int, format string, args ...interface{}) {
+	line := c.line + strings.Count(c.src[:offset], "\n")
+	c.problems = append(c.problems, problem{
+		pos: fmt.Sprintf("%s:%d", c.file, line),
+		msg: fmt.Sprintf(format, args...),
+	})
+}
+
+// frame is an element with the variables it introduces by v-for or scope
+type frame struct {
+	tag  string
+	vars map[string]bool
+}
+
+var (
+	attrRE      = regexp.MustCompile(`([^\s"'<>/=]+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+)))?`)
+	vForRE      = regexp.MustCompile(`^\s*(?:\(([^)]*)\)|([^\s]+))\s+(?:in|of)\s+(.*)$`)
+	identStartR = func(b byte) bool { return b == '_' || b == '$' || isLetter(b) }
+)
+
+func isLetter(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+func isIdentChar(b byte) bool {
+	return identStartR(b) || '0' <= b && b <= '9'
+}
+
+// check scans the template
+func (c *checker) check() {
+	src := c.src
+	stack := []*frame{}
+	inScope := func(name string) bool {
+		for _, f := range stack {
+			if f.vars[name] {
+				return true
+			}
+		}
+		return false
+	}
+	for i := 0; i < len(src); {
+		switch {
+		case strings.HasPrefix(src[i:], "<!--"):
+			end := strings.Index(src[i:], "-->")
+			if end < 0 {
+				return
+			}
+			i += end + 3
+		case strings.HasPrefix(src[i:], "</"):
+			end := strings.IndexByte(src[i:], '>')
+			if end < 0 {
+				return
+			}
+			tag := strings.ToLower(strings.TrimSpace(src[i+2 : i+end]))
+			for k := len(stack) - 1; k >= 0; k-- {
+				if stack[k].tag == tag {
+					stack = stack[:k]
+					break
+				}
+			}
+			i += end + 1
+		case src[i] == '<' && i+1 < len(src) && isLetter(src[i+1]):
+			end := tagEnd(src, i)
+			if end < 0 {
+				return
+			}
+			body := src[i+1 : end]
+			selfClose := strings.HasSuffix(body, "/")
+			body = strings.TrimSuffix(body, "/")
+			j := 0
+			for j < len(body) && !isSpace(body[j]) {
+				j++
+			}
+			rawTag := body[:j]
+			tag := strings.ToLower(rawTag)
+			f := &frame{tag: tag, vars: make(map[string]bool)}
+			c.checkComponent(i+1, rawTag)
+			// v-for and scope variables are visible in the element itself
+			attrs := attrRE.FindAllStringSubmatchIndex(body[j:], -1)
+			for _, a := range attrs {
+				name := body[j+a[2] : j+a[3]]
+				if name == "scope" || name == "slot-scope" {
+					for _, v := range params(attrValue(body[j:], a)) {
+						f.vars[v] = true
+					}
+				}
+			}
+			stack = append(stack, f)
+			pre := false
+			for _, a := range attrs {
+				name := body[j+a[2] : j+a[3]]
+				// VueJS decodes entities like `&quot;` in attribute values
+				value := html.UnescapeString(attrValue(body[j:], a))
+				offset := i + 1 + j + a[0]
+				if name == "v-pre" {
+					pre = true
+				}
+				c.checkAttr(offset, name, value, f, inScope)
+			}
+			if selfClose || voidElements[tag] {
+				stack = stack[:len(stack)-1]
+			}
+			i = end + 1
+			if pre {
+				// skip the whole element
+				close := strings.Index(src[i:], "</"+rawTag)
+				if close < 0 {
+					return
+				}
+				i += close
+			}
+		case strings.HasPrefix(src[i:], "{{"):
+			// mustaches may contain `<` thus are scanned before tags
+			end := strings.Index(src[i+2:], "}}")
+			if end < 0 {
+				return
+			}
+			c.checkExpr(i+2, src[i+2:i+2+end], true, inScope)
+			i += end + 4
+		default:
+			i++
+		}
+	}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f'
+}
+
+// tagEnd returns the offset of `>` closing the start tag at i
+func tagEnd(src string, i int) int {
+	var quote byte
+	for k := i + 1; k < len(src); k++ {
+		switch {
+		case quote != 0:
+			if src[k] == quote {
+				quote = 0
+			}
+		case src[k] == '"' || src[k] == '\'':
+			quote = src[k]
+		case src[k] == '>':
+			return k
+		}
+	}
+	return -1
+}
+
+func attrValue(body string, a []int) string {
+	for k := 4; k+1 < len(a); k += 2 {
+		if a[k] >= 0 {
+			return body[a[k]:a[k+1]]
+		}
+	}
+	return ""
+}
+
+// params parses variable declarations like `(item, index)` or `props`
+func params(s string) []string {
+	s = strings.Trim(strings.TrimSpace(s), "()")
+	var list []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+func (c *checker) checkComponent(offset int, tag string) {
+	name := kebab(tag)
+	if !strings.Contains(name, "-") && tag == strings.ToLower(tag) {
+		// plain HTML elements
+		return
+	}
+	if builtinComponents[name] || c.components[name] {
+		return
+	}
+	c.report(offset, "unknown component %q", tag)
+}
+
+// camelize converts `max-count` into `maxCount` as VueJS does for props
+func camelize(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '-' && i+1 < len(s) && isIdentChar(s[i+1]) && s[i+1] != '$' {
+			i++
+			b.WriteString(strings.ToUpper(s[i : i+1]))
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// kebab converts `TodoItem` into `todo-item`
+func kebab(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if 'A' <= ch && ch <= 'Z' {
+			if i > 0 && s[i-1] != '-' {
+				b.WriteByte('-')
+			}
+			ch += 'a' - 'A'
+		}
+		b.WriteByte(ch)
+	}
+	return b.String()
+}
+
+func (c *checker) checkAttr(offset int, name, value string, f *frame, inScope func(string) bool) {
+	switch {
+	case name == "v-for":
+		m := vForRE.FindStringSubmatch(value)
+		if m == nil {
+			c.report(offset, "invalid v-for expression %q", value)
+			return
+		}
+		// the list expression is evaluated outside of the aliases
+		c.checkExpr(offset, m[3], false, func(n string) bool {
+			return inScope(n) && !f.vars[n]
+		})
+		alias := m[1]
+		if alias == "" {
+			alias = m[2]
+		}
+		for _, v := range params(alias) {
+			f.vars[v] = true
+		}
+	case name == "v-else" || name == "v-cloak" || name == "v-pre" || name == "v-once":
+	case name == "scope" || name == "slot-scope":
+	case strings.HasPrefix(name, "@") || strings.HasPrefix(name, "v-on:"):
+		c.checkHandler(offset, value, inScope)
+	case strings.HasPrefix(name, ":") || strings.HasPrefix(name, "v-bind:"):
+		c.checkExpr(offset, value, true, inScope)
+	case strings.HasPrefix(name, "v-"):
+		if value != "" {
+			c.checkExpr(offset, value, false, inScope)
+		}
+	}
+}
+
+var simplePathRE = regexp.MustCompile(`^[A-Za-z_$][\w$]*(?:\.[A-Za-z_$][\w$]*|\['.*?'\]|\[".*?"\]|\[\d+\]|\[[A-Za-z_$][\w$]*\])*$`)
+
+// checkHandler checks v-on values which are method names or statements
+func (c *checker) checkHandler(offset int, value string, inScope func(string) bool) {
+	value = strings.TrimSpace(value)
+	if !strings.ContainsAny(value, ".[") && simplePathRE.MatchString(value) {
+		if !c.names.methods[value] && !inScope(value) {
+			if c.names.fields[value] {
+				// a function valued field
+				return
+			}
+			c.report(offset, "unknown method %q of %s", value, c.model)
+		}
+		return
+	}
+	c.checkExpr(offset, value, false, inScope)
+}
+
+// checkExpr checks the free identifiers and filters of a JavaScript
+// expression, `offset` is the position of the expression in the template
+func (c *checker) checkExpr(offset int, expr string, filters bool, inScope func(string) bool) {
+	parts := []string{expr}
+	if filters {
+		parts = splitFilters(expr)
+	}
+	arrow := arrowParams(expr)
+	known := func(id string) bool {
+		return globals[id] || strings.HasPrefix(id, "$") || arrow[id] || inScope(id) || c.names.has(id)
+	}
+	for _, id := range identifiers(parts[0]) {
+		if known(id) {
+			continue
+		}
+		c.report(offset, "unknown field or method %q of %s", id, c.model)
+	}
+	for _, f := range parts[1:] {
+		f = strings.TrimSpace(f)
+		name := f
+		if k := strings.IndexByte(f, '('); k >= 0 {
+			name = strings.TrimSpace(f[:k])
+			for _, id := range identifiers(f[k:]) {
+				if known(id) {
+					continue
+				}
+				c.report(offset, "unknown field or method %q of %s", id, c.model)
+			}
+		}
+		if !c.filters[name] {
+			c.report(offset, "unknown filter %q", name)
+		}
+	}
+}
+
+// arrowParams returns the parameters of the arrow functions in expr like
+// `x => x.done` or `(a, b) => a - b`, they are treated as known in the
+// whole expression.
+func arrowParams(expr string) map[string]bool {
+	vars := make(map[string]bool)
+	for i := strings.Index(expr, "=>"); i >= 0; {
+		head := strings.TrimRight(expr[:i], " \t\r\n")
+		if strings.HasSuffix(head, ")") {
+			if open := strings.LastIndexByte(head, '('); open >= 0 {
+				for _, p := range params(head[open:]) {
+					vars[p] = true
+				}
+			}
+		} else {
+			k := len(head)
+			for k > 0 && isIdentChar(head[k-1]) {
+				k--
+			}
+			vars[head[k:]] = true
+		}
+		next := strings.Index(expr[i+2:], "=>")
+		if next < 0 {
+			break
+		}
+		i += next + 2
+	}
+	return vars
+}
+
+// splitFilters splits `expr | filterA | filterB(arg)`, `||` is not a filter
+func splitFilters(expr string) []string {
+	var parts []string
+	depth := 0
+	var quote byte
+	last := 0
+	for i := 0; i < len(expr); i++ {
+		ch := expr[i]
+		switch {
+		case quote != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'' || ch == '`':
+			quote = ch
+		case ch == '(' || ch == '[' || ch == '{':
+			depth++
+		case ch == ')' || ch == ']' || ch == '}':
+			depth--
+		case ch == '|' && depth == 0:
+			if i+1 < len(expr) && expr[i+1] == '|' {
+				i++
+				continue
+			}
+			if i > 0 && expr[i-1] == '|' {
+				continue
+			}
+			parts = append(parts, expr[last:i])
+			last = i + 1
+		}
+	}
+	return append(parts, expr[last:])
+}
+
+// identifiers returns the free identifiers of a JavaScript expression,
+// member names and object literal keys are left out.
+func identifiers(expr string) []string {
+	var ids []string
+	prev := byte(0) // previous non space char
+	for i := 0; i < len(expr); {
+		ch := expr[i]
+		switch {
+		case ch == '"' || ch == '\'' || ch == '`':
+			k := i + 1
+			for k < len(expr) && expr[k] != ch {
+				if expr[k] == '\\' {
+					k++
+				}
+				k++
+			}
+			i = k + 1
+			prev = ch
+		case '0' <= ch && ch <= '9':
+			for i < len(expr) && (isIdentChar(expr[i]) || expr[i] == '.') {
+				i++
+			}
+			prev = '0'
+		case identStartR(ch):
+			k := i
+			for k < len(expr) && isIdentChar(expr[k]) {
+				k++
+			}
+			id := expr[i:k]
+			next := byte(0)
+			for n := k; n < len(expr); n++ {
+				if !isSpace(expr[n]) {
+					next = expr[n]
+					break
+				}
+			}
+			isMember := prev == '.'
+			isKey := next == ':' && (prev == '{' || prev == ',')
+			if !isMember && !isKey {
+				ids = append(ids, id)
+			}
+			i = k
+			prev = 'a'
+		default:
+			if !isSpace(ch) {
+				prev = ch
+			}
+			i++
+		}
+	}
+	return ids
+}
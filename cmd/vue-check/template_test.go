@@ -0,0 +1,140 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// checkTemplate checks tpl against a model with `fields` and `methods`,
+// the filter `upper` and the component `todo-item` are registered.
+func checkTemplate(tpl string, fields, methods string) []string {
+	n := newNames()
+	for _, f := range strings.Fields(fields) {
+		n.fields[f] = true
+	}
+	for _, m := range strings.Fields(methods) {
+		n.methods[m] = true
+	}
+	c := &checker{
+		names:      n,
+		filters:    map[string]bool{"upper": true},
+		components: map[string]bool{"todo-item": true},
+		model:      "Model",
+		file:       "t.go",
+		line:       10,
+		src:        tpl,
+	}
+	c.check()
+	var msgs []string
+	for _, p := range c.problems {
+		msgs = append(msgs, p.pos+": "+p.msg)
+	}
+	return msgs
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name, tpl       string
+		fields, methods string
+		want            []string
+	}{
+		{
+			name:   "known names",
+			tpl:    `<div :class="{active: done}" @click="Toggle">{{ title | upper }} {{ Math.max(count, 1) }}</div>`,
+			fields: "done title count", methods: "Toggle",
+		},
+		{
+			name:   "unknown field in mustache",
+			tpl:    "<div>\n{{ titel }}</div>",
+			fields: "title",
+			want:   []string{`t.go:11: unknown field or method "titel" of Model`},
+		},
+		{
+			name:    "unknown method handler",
+			tpl:     `<button @click="Repet">x</button><button v-on:click="Repeat()">y</button>`,
+			methods: "Repeat",
+			want:    []string{`t.go:10: unknown method "Repet" of Model`},
+		},
+		{
+			name:   "handler statement",
+			tpl:    `<button @click="count++; total += $event.x">x</button>`,
+			fields: "count",
+			want:   []string{`t.go:10: unknown field or method "total" of Model`},
+		},
+		{
+			name:   "unknown filter",
+			tpl:    `<p>{{ a | lower }}</p><p :title="a | upper">{{ a || b }}</p>`,
+			fields: "a b",
+			want:   []string{`t.go:10: unknown filter "lower"`},
+		},
+		{
+			name:   "v-for aliases",
+			tpl:    `<ul><li v-for="(item, i) in items" :key="i">{{ item.name }}</li></ul><p>{{ item }}</p>`,
+			fields: "items",
+			want:   []string{`t.go:10: unknown field or method "item" of Model`},
+		},
+		{
+			name: "v-for list is outside of the aliases",
+			tpl:  `<li v-for="item in item.children">{{ item }}</li>`,
+			want: []string{`t.go:10: unknown field or method "item" of Model`},
+		},
+		{
+			name:   "scoped slot",
+			tpl:    `<todo-item><template scope="props"><span>{{ props.text }}</span></template></todo-item>`,
+			fields: "",
+		},
+		{
+			name: "components",
+			tpl:  `<todo-item></todo-item><TodoItem/><router-view></router-view><my-list></my-list>`,
+			want: []string{`t.go:10: unknown component "my-list"`},
+		},
+		{
+			name:   "less than in expressions",
+			tpl:    `<p v-if="count<max">{{ count<max }}</p><p>1 < 2</p>`,
+			fields: "count max",
+		},
+		{
+			name:   "arrow functions",
+			tpl:    `<p>{{ items.filter(x => x.done).map((a, b) => a + b + y) }}</p>`,
+			fields: "items",
+			want:   []string{`t.go:10: unknown field or method "y" of Model`},
+		},
+		{
+			name: "comments and v-pre",
+			tpl:  `<!-- {{ nope }} --><pre v-pre>{{ raw }}</pre>`,
+		},
+		{
+			name:   "member access and strings",
+			tpl:    `<p :title="'a ' + user.name + &quot;x&quot;">{{ "it's " + obj['key'] }}</p>`,
+			fields: "user obj",
+		},
+	}
+	for _, tt := range tests {
+		got := checkTemplate(tt.tpl, tt.fields, tt.methods)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s:\n got %q\nwant %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSplitFilters(t *testing.T) {
+	tests := map[string][]string{
+		"a | b":             {"a ", " b"},
+		"a || b":            {"a || b"},
+		"f(a | 1) | g('|')": {"f(a | 1) ", " g('|')"},
+	}
+	for expr, want := range tests {
+		if got := splitFilters(expr); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitFilters(%q) = %q, want %q", expr, got, want)
+		}
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	got := identifiers(`a.b + c[d] + {e: f, 'g': h} + "i" + 1.5 + j(k)`)
+	want := []string{"a", "c", "d", "f", "h", "j", "k"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("identifiers = %q, want %q", got, want)
+	}
+}
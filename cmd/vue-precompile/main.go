@@ -0,0 +1,296 @@
+// Command vue-precompile compiles the string templates of a gopherjs-vue
+// package into VueJS render functions at build time.
+//
+// Templates passed to `NewComponent` and assigned to `Option.Template` are
+// collected, constants and constant expressions are supported. The render
+// functions are written into a `.inc.js` file of the package which GopherJS
+// includes in the output automatically, gopherjs-vue uses them instead of
+// the templates when the options are prepared.
+//
+// Usage:
+//
+//	vue-precompile [-o templates.inc.js] [-vue vue.js] [package dir]
+//
+// or with go generate:
+//
+//	//go:generate vue-precompile
+//
+// NodeJS is required to run the VueJS template compiler. Combined with
+// the `runtime` build tag the template compiler is left out entirely:
+//
+//	gopherjs build --tags runtime
+//
+// Templates in the DOM like the element mounted by `vue.New("#app", m)` are
+// not collected, they can not be used with the `runtime` build tag.
+//
+// The VueJS compiler wraps render functions in `with(this){...}` which is
+// not allowed in strict mode code like GopherJS output, the generated code
+// references the instance by `_vm` instead as vue-loader does.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const vuePkg = "github.com/oskca/gopherjs-vue/jscode/debug"
+
+var (
+	output  = flag.String("o", "templates.inc.js", "output file, relative to the package dir")
+	vueFile = flag.String("vue", "", "VueJS standalone build with the template compiler, defaults to the debug build of gopherjs-vue")
+)
+
+// template is a template string found in the source code
+type template struct {
+	pos token.Position
+	src string
+}
+
+// compiled is the result of the VueJS template compiler
+type compiled struct {
+	Render          string   `json:"render"`
+	StaticRenderFns []string `json:"staticRenderFns"`
+	Errors          []string `json:"errors"`
+}
+
+func main() {
+	flag.Parse()
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	if err := run(dir); err != nil {
+		fmt.Fprintln(os.Stderr, "vue-precompile:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	templates, err := collect(dir)
+	if err != nil {
+		return err
+	}
+	if len(templates) == 0 {
+		return nil
+	}
+	vue := *vueFile
+	if vue == "" {
+		pkg, err := build.Import(vuePkg, dir, build.FindOnly)
+		if err != nil {
+			return err
+		}
+		vue = filepath.Join(pkg.Dir, "vue-2.1.10.inc.js")
+	}
+	results, err := compile(vue, templates)
+	if err != nil {
+		return err
+	}
+	failed := false
+	for i, r := range results {
+		for _, e := range r.Errors {
+			failed = true
+			fmt.Fprintf(os.Stderr, "%s: %s\n", templates[i].pos, e)
+		}
+	}
+	if failed {
+		return fmt.Errorf("failed to compile templates")
+	}
+	out := *output
+	if !filepath.IsAbs(out) {
+		out = filepath.Join(dir, out)
+	}
+	code, err := generate(templates, results)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(out, code, 0644)
+}
+
+// collect finds the constant template strings of the package in dir
+func collect(dir string) ([]*template, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	var templates []*template
+	seen := make(map[string]bool)
+	for _, pkg := range pkgs {
+		var files []*ast.File
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+		// only constant values are needed, errors like unresolved
+		// imports are ignored
+		info := &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+		}
+		conf := types.Config{
+			Importer: importer.Default(),
+			Error:    func(error) {},
+		}
+		conf.Check(pkg.Name, fset, files, info)
+		add := func(expr ast.Expr) {
+			tv, ok := info.Types[expr]
+			if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+				return
+			}
+			src := constant.StringVal(tv.Value)
+			// `#id` templates are resolved from the DOM at runtime
+			if src == "" || strings.HasPrefix(src, "#") || seen[src] {
+				return
+			}
+			seen[src] = true
+			templates = append(templates, &template{
+				pos: fset.Position(expr.Pos()),
+				src: src,
+			})
+		}
+		for _, f := range files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.CallExpr:
+					if isSelector(n.Fun, "NewComponent") && len(n.Args) >= 2 {
+						add(n.Args[1])
+					}
+				case *ast.AssignStmt:
+					for i, lhs := range n.Lhs {
+						if isSelector(lhs, "Template") && i < len(n.Rhs) {
+							add(n.Rhs[i])
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+	return templates, nil
+}
+
+func isSelector(expr ast.Expr, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == name
+}
+
+// compileScript runs the VueJS template compiler in NodeJS, it reads the
+// templates as a JSON array from stdin and writes the results to stdout.
+const compileScript = `
+var errors = [];
+console.error = function () {
+	errors.push(Array.prototype.join.call(arguments, " "));
+};
+// the compiler decodes HTML entities through the DOM
+var entities = {amp: "&", lt: "<", gt: ">", quot: "\"", apos: "'", nbsp: " "};
+global.document = {
+	createElement: function () {
+		return {
+			set innerHTML(html) {
+				this.textContent = html.replace(/&(#x?[0-9a-f]+|[a-z]+);/gi, function (m, e) {
+					if (e[0] === "#") {
+						return String.fromCharCode(e[1] === "x" || e[1] === "X" ?
+							parseInt(e.slice(2), 16) : parseInt(e.slice(1), 10));
+					}
+					return entities[e] === undefined ? m : entities[e];
+				});
+			}
+		};
+	}
+};
+var Vue = require(process.argv[1]);
+Vue.config.productionTip = false;
+var input = "";
+process.stdin.on("data", function (d) { input += d; });
+process.stdin.on("end", function () {
+	var results = JSON.parse(input).map(function (tpl) {
+		errors = [];
+		var r = Vue.compile(tpl);
+		return {
+			render: r.render.toString(),
+			staticRenderFns: r.staticRenderFns.map(function (fn) { return fn.toString(); }),
+			errors: errors
+		};
+	});
+	process.stdout.write(JSON.stringify(results));
+	process.exit(0);
+});
+`
+
+func compile(vue string, templates []*template) ([]*compiled, error) {
+	vue, err := filepath.Abs(vue)
+	if err != nil {
+		return nil, err
+	}
+	srcs := make([]string, len(templates))
+	for i, t := range templates {
+		srcs[i] = t.src
+	}
+	input, err := json.Marshal(srcs)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("node", "-e", compileScript, vue)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("node: %v\n%s", err, stderr.String())
+	}
+	var results []*compiled
+	if err := json.Unmarshal(stdout.Bytes(), &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(templates) {
+		return nil, fmt.Errorf("node: expect %d results, got %d", len(templates), len(results))
+	}
+	return results, nil
+}
+
+// generate creates the `.inc.js` file registering the render functions,
+// `with(this)` is stripped from them since GopherJS output is strict code.
+func generate(templates []*template, results []*compiled) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by vue-precompile. DO NOT EDIT.\n\n")
+	buf.WriteString("(function (g) {\n")
+	buf.WriteString("var t = g.$gopherjsVueTemplates || (g.$gopherjsVueTemplates = {});\n")
+	for i, tpl := range templates {
+		key, _ := json.Marshal(tpl.src)
+		r := results[i]
+		render, err := stripWith(r.Render)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", tpl.pos, err)
+		}
+		fmt.Fprintf(&buf, "\n// %s\nt[%s] = {\n", filepath.Base(tpl.pos.Filename)+fmt.Sprintf(":%d", tpl.pos.Line), key)
+		fmt.Fprintf(&buf, "render: %s,\n", render)
+		buf.WriteString("staticRenderFns: [")
+		for j, fn := range r.StaticRenderFns {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			fn, err := stripWith(fn)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", tpl.pos, err)
+			}
+			buf.WriteString(fn)
+		}
+		buf.WriteString("]\n};\n")
+	}
+	buf.WriteString("})(typeof window !== 'undefined' ? window : this);\n")
+	return buf.Bytes(), nil
+}
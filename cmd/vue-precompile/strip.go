@@ -0,0 +1,503 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The VueJS template compiler generates render functions wrapped in
+// `with(this){...}` which is a syntax error in strict mode code, and
+// GopherJS output is always strict. stripWith rewrites the render
+// functions into the form vue-loader produces, all free identifiers are
+// prefixed by `_vm.` which is the instance:
+//
+//	function () {var _vm=this;return _vm._c('div',[_vm._v(_vm._s(_vm.msg))])}
+//
+// The rewriting is done by a scanner aware of the scoping forms the VueJS
+// code generator and template expressions produce: function and arrow
+// function parameters, `var` declarations, object literal keys and member
+// accesses are left as is, so are the globals VueJS allows in templates.
+
+// allowedGlobals are the globals accessible in VueJS templates
+var allowedGlobals = setOf("Infinity", "undefined", "NaN", "isFinite", "isNaN",
+	"parseFloat", "parseInt", "decodeURI", "decodeURIComponent", "encodeURI",
+	"encodeURIComponent", "Math", "Number", "Date", "Array", "Object",
+	"Boolean", "String", "RegExp", "Map", "Set", "JSON", "Intl", "require",
+	"arguments")
+
+var keywords = setOf("break", "case", "catch", "class", "const", "continue",
+	"debugger", "default", "delete", "do", "else", "export", "extends",
+	"finally", "for", "function", "if", "import", "in", "instanceof", "let",
+	"new", "return", "super", "switch", "this", "throw", "try", "typeof",
+	"var", "void", "while", "with", "yield", "true", "false", "null", "of")
+
+func setOf(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
+
+// stripWith rewrites a render function compiled by VueJS, `fn` is the
+// source of the function whose body is `with(this){...}`
+func stripWith(fn string) (string, error) {
+	const with = "with(this){"
+	start := strings.Index(fn, with)
+	end := strings.LastIndex(fn, "}")
+	if start < 0 || end < 0 {
+		return "", fmt.Errorf("no with(this) block in render function")
+	}
+	end = strings.LastIndex(fn[:end], "}")
+	if end < start+len(with) {
+		return "", fmt.Errorf("malformed with(this) block in render function")
+	}
+	body, err := prefixFree(fn[start+len(with):end], "_vm.")
+	if err != nil {
+		return "", err
+	}
+	return "function () {var _vm=this;" + body + "}", nil
+}
+
+// scope is a function scope
+type scope struct {
+	names  map[string]bool
+	parent *scope
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{names: make(map[string]bool), parent: parent}
+}
+
+func (s *scope) bound(name string) bool {
+	for ; s != nil; s = s.parent {
+		if s.names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+type frameKind int
+
+const (
+	paren frameKind = iota
+	bracket
+	object
+	block
+	arrowExpr   // expression body of an arrow function
+	tmplString  // string parts of a template literal
+	tmplExpr    // ${...} of a template literal
+	paramsParen // parameter list of a function
+)
+
+type frame struct {
+	kind  frameKind
+	scope *scope
+	// varDecl is true in a `var` statement at the frame level
+	varDecl bool
+}
+
+// token kinds of the previous significant token
+const (
+	tokNone = iota
+	tokIdent
+	tokKeyword
+	tokValue // numbers, strings, regexps and template literals
+	tokPunct
+)
+
+type prefixer struct {
+	src    string
+	i      int
+	out    strings.Builder
+	prefix string
+	frames []*frame
+	// previous significant token
+	prevKind int
+	prev     string
+	// scope of the function or arrow function whose body comes next
+	nextScope *scope
+	// pendingFunc is set after the `function` keyword
+	pendingFunc bool
+}
+
+// prefixFree prefixes all free identifiers in the JavaScript `code`
+func prefixFree(code, prefix string) (string, error) {
+	p := &prefixer{
+		src:    code,
+		prefix: prefix,
+		frames: []*frame{{kind: block, scope: newScope(nil)}},
+	}
+	if err := p.run(); err != nil {
+		return "", err
+	}
+	return p.out.String(), nil
+}
+
+func (p *prefixer) top() *frame {
+	return p.frames[len(p.frames)-1]
+}
+
+func (p *prefixer) push(kind frameKind, s *scope) {
+	if s == nil {
+		s = p.top().scope
+	}
+	p.frames = append(p.frames, &frame{kind: kind, scope: s})
+}
+
+func (p *prefixer) pop() *frame {
+	f := p.top()
+	if len(p.frames) == 1 {
+		return f
+	}
+	p.frames = p.frames[:len(p.frames)-1]
+	return f
+}
+
+// popArrows ends the expression bodies of arrow functions
+func (p *prefixer) popArrows() {
+	for p.top().kind == arrowExpr {
+		p.pop()
+	}
+}
+
+func (p *prefixer) setPrev(kind int, tok string) {
+	p.prevKind, p.prev = kind, tok
+}
+
+func (p *prefixer) run() error {
+	for p.i < len(p.src) {
+		if p.top().kind == tmplString {
+			if err := p.templateString(); err != nil {
+				return err
+			}
+			continue
+		}
+		c := p.src[p.i]
+		switch {
+		case isSpace(c):
+			p.out.WriteByte(c)
+			p.i++
+		case c == '"' || c == '\'':
+			end, err := stringEnd(p.src, p.i)
+			if err != nil {
+				return err
+			}
+			p.out.WriteString(p.src[p.i:end])
+			p.i = end
+			p.setPrev(tokValue, "")
+		case c == '`':
+			p.out.WriteByte(c)
+			p.i++
+			p.push(tmplString, nil)
+		case isIdentStart(c):
+			p.ident()
+		case isDigit(c) || c == '.' && p.i+1 < len(p.src) && isDigit(p.src[p.i+1]):
+			j := p.i + 1
+			for j < len(p.src) && (isIdentChar(p.src[j]) || p.src[j] == '.' ||
+				(p.src[j] == '+' || p.src[j] == '-') && (p.src[j-1] == 'e' || p.src[j-1] == 'E')) {
+				j++
+			}
+			p.out.WriteString(p.src[p.i:j])
+			p.i = j
+			p.setPrev(tokValue, "")
+		case c == '/' && p.regexAllowed():
+			end, err := regexEnd(p.src, p.i)
+			if err != nil {
+				return err
+			}
+			p.out.WriteString(p.src[p.i:end])
+			p.i = end
+			p.setPrev(tokValue, "")
+		default:
+			p.punct()
+		}
+	}
+	if len(p.frames) != 1 {
+		return fmt.Errorf("unbalanced brackets in render function")
+	}
+	return nil
+}
+
+// regexAllowed reports whether a `/` starts a regexp literal
+func (p *prefixer) regexAllowed() bool {
+	switch p.prevKind {
+	case tokIdent, tokValue:
+		return false
+	case tokPunct:
+		return p.prev != ")" && p.prev != "]" && p.prev != "}"
+	}
+	return true
+}
+
+func (p *prefixer) templateString() error {
+	for p.i < len(p.src) {
+		c := p.src[p.i]
+		switch {
+		case c == '\\' && p.i+1 < len(p.src):
+			p.out.WriteString(p.src[p.i : p.i+2])
+			p.i += 2
+		case c == '`':
+			p.out.WriteByte(c)
+			p.i++
+			p.pop()
+			p.setPrev(tokValue, "")
+			return nil
+		case c == '$' && strings.HasPrefix(p.src[p.i:], "${"):
+			p.out.WriteString("${")
+			p.i += 2
+			p.push(tmplExpr, nil)
+			p.setPrev(tokPunct, "(")
+			return nil
+		default:
+			p.out.WriteByte(c)
+			p.i++
+		}
+	}
+	return fmt.Errorf("unterminated template literal in render function")
+}
+
+func (p *prefixer) punct() {
+	c := p.src[p.i]
+	tok := string(c)
+	for _, op := range []string{"=>", "...", "===", "!==", "==", "!=", "<=", ">=",
+		"&&", "||", "++", "--", "+=", "-=", "*=", "/="} {
+		if strings.HasPrefix(p.src[p.i:], op) {
+			tok = op
+			break
+		}
+	}
+	p.i += len(tok)
+	nextScope := p.nextScope
+	p.nextScope = nil
+	switch tok {
+	case "(":
+		if p.pendingFunc {
+			p.pendingFunc = false
+			p.push(paramsParen, newScope(p.top().scope))
+		} else if params, ok := p.arrowParams(); ok {
+			s := newScope(p.top().scope)
+			for _, name := range params {
+				s.names[name] = true
+			}
+			p.push(paramsParen, s)
+		} else {
+			p.push(paren, nil)
+		}
+	case "[":
+		p.push(bracket, nil)
+	case "{":
+		if nextScope != nil || p.isBlock() {
+			p.push(block, nextScope)
+		} else {
+			p.push(object, nil)
+		}
+	case ")", "]":
+		p.popArrows()
+		if f := p.pop(); f.kind == paramsParen {
+			// the function or arrow function body follows
+			p.nextScope = f.scope
+		}
+	case "}":
+		p.popArrows()
+		p.pop()
+	case ",":
+		p.popArrows()
+	case ";":
+		p.popArrows()
+		p.top().varDecl = false
+	case "=>":
+		if nextScope == nil {
+			nextScope = newScope(p.top().scope)
+		}
+		p.nextScope = nextScope
+		if !strings.HasPrefix(strings.TrimLeft(p.src[p.i:], " \t\r\n"), "{") {
+			p.push(arrowExpr, nextScope)
+			p.nextScope = nil
+		}
+	}
+	p.out.WriteString(tok)
+	p.setPrev(tokPunct, tok)
+}
+
+// isBlock reports whether a `{` starts a block instead of an object literal
+func (p *prefixer) isBlock() bool {
+	switch p.prevKind {
+	case tokNone:
+		return true
+	case tokKeyword:
+		return p.prev == "else" || p.prev == "do" || p.prev == "try" || p.prev == "finally"
+	case tokPunct:
+		switch p.prev {
+		case ")", ";", "{", "}":
+			return p.top().kind == block || p.prev == ")"
+		}
+	}
+	return false
+}
+
+// arrowParams returns the parameters if the `(` just read starts the
+// parameter list of an arrow function
+func (p *prefixer) arrowParams() ([]string, bool) {
+	depth := 1
+	j := p.i
+	for j < len(p.src) && depth > 0 {
+		switch c := p.src[j]; {
+		case c == '"' || c == '\'' || c == '`':
+			end, err := stringEnd(p.src, j)
+			if err != nil {
+				return nil, false
+			}
+			j = end
+			continue
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		}
+		j++
+	}
+	if depth > 0 || !strings.HasPrefix(strings.TrimLeft(p.src[j:], " \t\r\n"), "=>") {
+		return nil, false
+	}
+	var params []string
+	for _, part := range strings.Split(p.src[p.i:j-1], ",") {
+		part = strings.TrimSpace(part)
+		part = strings.TrimPrefix(part, "...")
+		if k := strings.IndexByte(part, '='); k >= 0 {
+			part = strings.TrimSpace(part[:k])
+		}
+		if part != "" {
+			params = append(params, part)
+		}
+	}
+	return params, true
+}
+
+func (p *prefixer) ident() {
+	j := p.i
+	for j < len(p.src) && isIdentChar(p.src[j]) {
+		j++
+	}
+	name := p.src[p.i:j]
+	p.i = j
+	p.nextScope = nil
+	if keywords[name] {
+		switch name {
+		case "function":
+			p.pendingFunc = true
+		case "var", "let", "const":
+			p.top().varDecl = true
+		}
+		p.out.WriteString(name)
+		p.setPrev(tokKeyword, name)
+		return
+	}
+	next := p.nextToken()
+	f := p.top()
+	switch {
+	case p.prevKind == tokPunct && p.prev == ".":
+		// member access
+	case p.pendingFunc:
+		// function name
+	case f.kind == paramsParen:
+		if p.prevKind == tokPunct && (p.prev == "(" || p.prev == "," || p.prev == "...") {
+			f.scope.names[name] = true
+		} else if !f.scope.bound(name) && !allowedGlobals[name] {
+			name = p.prefix + name
+		}
+	case next == "=>":
+		s := newScope(f.scope)
+		s.names[name] = true
+		p.out.WriteString(name)
+		p.setPrev(tokIdent, name)
+		p.nextScope = s
+		return
+	case f.kind == object && p.prevKind == tokPunct && (p.prev == "{" || p.prev == ","):
+		if next == "," || next == "}" {
+			// shorthand property
+			if !f.scope.bound(name) && !allowedGlobals[name] {
+				name = name + ":" + p.prefix + name
+			}
+		}
+	case f.varDecl && (p.prevKind == tokKeyword && p.prev == "var" ||
+		p.prevKind == tokKeyword && (p.prev == "let" || p.prev == "const") ||
+		p.prevKind == tokPunct && p.prev == ","):
+		f.scope.names[name] = true
+	case f.scope.bound(name) || allowedGlobals[name]:
+	default:
+		name = p.prefix + name
+	}
+	p.out.WriteString(name)
+	p.setPrev(tokIdent, name)
+}
+
+// nextToken peeks the next significant punctuation
+func (p *prefixer) nextToken() string {
+	rest := strings.TrimLeft(p.src[p.i:], " \t\r\n")
+	if strings.HasPrefix(rest, "=>") {
+		return "=>"
+	}
+	if rest == "" {
+		return ""
+	}
+	return rest[:1]
+}
+
+// stringEnd returns the end offset of the quoted string at i
+func stringEnd(src string, i int) (int, error) {
+	q := src[i]
+	for j := i + 1; j < len(src); j++ {
+		switch src[j] {
+		case '\\':
+			j++
+		case q:
+			return j + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated string in render function")
+}
+
+// regexEnd returns the end offset of the regexp literal at i
+func regexEnd(src string, i int) (int, error) {
+	class := false
+	for j := i + 1; j < len(src); j++ {
+		switch src[j] {
+		case '\\':
+			j++
+		case '[':
+			class = true
+		case ']':
+			class = false
+		case '/':
+			if class {
+				continue
+			}
+			j++
+			for j < len(src) && isIdentChar(src[j]) {
+				j++
+			}
+			return j, nil
+		case '\n':
+			return 0, fmt.Errorf("unterminated regexp in render function")
+		}
+	}
+	return 0, fmt.Errorf("unterminated regexp in render function")
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c == '$'
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
@@ -0,0 +1,56 @@
+package main
+
+import "testing"
+
+func TestPrefixFree(t *testing.T) {
+	tests := []struct {
+		code, want string
+	}{
+		{`return _c('div',[_v(_s(msg))])`,
+			`return _vm._c('div',[_vm._v(_vm._s(_vm.msg))])`},
+		{`return _c('p',{class:{active: isActive, 'x-y': a ? b : c}})`,
+			`return _vm._c('p',{class:{active: _vm.isActive, 'x-y': _vm.a ? _vm.b : _vm.c}})`},
+		{`return _c('p',{on:{"click":function($event){count++;select(item, $event)}}})`,
+			`return _vm._c('p',{on:{"click":function($event){_vm.count++;_vm.select(_vm.item, $event)}}})`},
+		{`return _l((items),function(item,i){return _s(item.name)+_s(Math.max(i, n))})`,
+			`return _vm._l((_vm.items),function(item,i){return _vm._s(item.name)+_vm._s(Math.max(i, _vm.n))})`},
+		{`var $$a=checked,$$el=$event.target;if(Array.isArray($$a)){checked=$$a.concat($$el)}`,
+			`var $$a=_vm.checked,$$el=_vm.$event.target;if(Array.isArray($$a)){_vm.checked=$$a.concat($$el)}`},
+		{`return items.filter(x => x.ok && y).map((a, b) => a + b + z)`,
+			`return _vm.items.filter(x => x.ok && _vm.y).map((a, b) => a + b + _vm.z)`},
+		{`return (count<max)?_c('p'):_e()`,
+			`return (_vm.count<_vm.max)?_vm._c('p'):_vm._e()`},
+		{`return {scopedSlots:{default:function(props){return [props.text, other]}}}`,
+			`return {scopedSlots:{default:function(props){return [props.text, _vm.other]}}}`},
+		{`var val = "_value" in o ? o._value : o.value;return val`,
+			`var val = "_value" in _vm.o ? _vm.o._value : _vm.o.value;return val`},
+		{"return `a ${b + `c${d}`} e`",
+			"return `a ${_vm.b + `c${_vm.d}`} e`"},
+		{`return {a, b: 1.5e+3, c: /a\/[/]b/g.test(s) / 2}`,
+			`return {a:_vm.a, b: 1.5e+3, c: /a\/[/]b/g.test(_vm.s) / 2}`},
+	}
+	for _, tt := range tests {
+		got, err := prefixFree(tt.code, "_vm.")
+		if err != nil {
+			t.Errorf("prefixFree(%q): %v", tt.code, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("prefixFree(%q)\n got %s\nwant %s", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestStripWith(t *testing.T) {
+	got, err := stripWith("function anonymous(\n) {\nwith(this){return _c('b',[_v(_s(a))])}\n}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "function () {var _vm=this;return _vm._c('b',[_vm._v(_vm._s(_vm.a))])}"
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if _, err := stripWith("function () {}"); err == nil {
+		t.Error("expect error without with(this)")
+	}
+}
@@ -0,0 +1,199 @@
+// Command vue-sfc generates Go source from VueJS single-file components
+// (`.vue` files) so the templates need not be copied into Go string
+// literals by hand.
+//
+// A `.vue` file consists of a `<template>`, an optional `<script>` and
+// optional `<style>` blocks. The `<script>` block holds the metadata of the
+// component as an object literal of strings and lists of strings, keys
+// may be unquoted and strings single quoted as in normal JavaScript:
+//
+//	<template>
+//	    <div class="item">{{ text }}</div>
+//	</template>
+//
+//	<script>
+//	export default {
+//	    name: 'todo-item',
+//	    creator: 'NewTodoItem',
+//	    props: ['todo'],
+//	}
+//	</script>
+//
+//	<style scoped>
+//	.item { color: red; }
+//	</style>
+//
+// `creator` is the Go func creating the data struct, see vue.NewComponent.
+// For `todo-item.vue` the file `todo_item_vue.go` is generated with
+//
+//	func RegisterTodoItem() *vue.Component
+//
+// which injects the styles and registers the component globally. Styles
+// marked `scoped` only apply to the elements of the component, the template
+// is left as written and VueJS adds the scope attribute to the elements
+// through the `_scopeId` option.
+//
+// Usage:
+//
+//	//go:generate vue-sfc todo-item.vue
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var pkgName = flag.String("pkg", "", "package name of the generated files, defaults to $GOPACKAGE or main")
+
+func main() {
+	flag.Parse()
+	if *pkgName == "" {
+		*pkgName = os.Getenv("GOPACKAGE")
+	}
+	if *pkgName == "" {
+		*pkgName = "main"
+	}
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: vue-sfc [-pkg name] file.vue...")
+		os.Exit(2)
+	}
+	for _, file := range flag.Args() {
+		if err := generateFile(file); err != nil {
+			fmt.Fprintf(os.Stderr, "vue-sfc: %s: %v\n", file, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func generateFile(file string) error {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	f, err := parseSFC(string(src))
+	if err != nil {
+		return err
+	}
+	if f.template == nil {
+		return fmt.Errorf("no <template> block")
+	}
+	m, err := parseMeta(f.script)
+	if err != nil {
+		return err
+	}
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	if m.Name == "" {
+		m.Name = base
+	}
+	code, err := generate(filepath.Base(file), scopeID(file), m, f)
+	if err != nil {
+		return err
+	}
+	out := filepath.Join(filepath.Dir(file), strings.Replace(base, "-", "_", -1)+"_vue.go")
+	return ioutil.WriteFile(out, code, 0644)
+}
+
+// goName converts `todo-item` into `TodoItem`
+func goName(name string) string {
+	var buf bytes.Buffer
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// scopeID returns the scope attribute of the component in `file`, it's
+// derived from the import path of the package and the file name, or the
+// absolute path outside of the GOPATH, so components of the same name in
+// different packages never share it.
+func scopeID(file string) string {
+	id, err := filepath.Abs(file)
+	if err != nil {
+		id = file
+	}
+	pkg, err := build.ImportDir(filepath.Dir(id), build.FindOnly)
+	if err == nil && pkg.ImportPath != "." && pkg.ImportPath != "" {
+		id = pkg.ImportPath + "/" + filepath.Base(file)
+	}
+	sum := sha1.Sum([]byte(filepath.ToSlash(id)))
+	return "data-v-" + hex.EncodeToString(sum[:4])
+}
+
+func generate(file, scope string, m *meta, f *sfc) ([]byte, error) {
+	name := goName(m.Name)
+	template := strings.TrimSpace(f.template.content)
+	css := ""
+	scoped := false
+	for _, s := range f.styles {
+		if strings.Contains(" "+s.attrs+" ", " scoped ") {
+			scoped = true
+			css += scopeCSS(s.content, scope)
+		} else {
+			css += s.content
+		}
+		css += "\n"
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by vue-sfc from %s. DO NOT EDIT.\n\n", file)
+	fmt.Fprintf(&buf, "package %s\n\n", *pkgName)
+	buf.WriteString("import \"github.com/oskca/gopherjs-vue\"\n\n")
+	fmt.Fprintf(&buf, "const %sTemplate = %s\n\n", lowerFirst(name), strconv.Quote(template))
+	if strings.TrimSpace(css) != "" {
+		fmt.Fprintf(&buf, "const %sStyle = %s\n\n", lowerFirst(name), strconv.Quote(css))
+	}
+	fmt.Fprintf(&buf, "// Register%s injects the styles and registers the %q component globally\n", name, m.Name)
+	fmt.Fprintf(&buf, "func Register%s() *vue.Component {\n", name)
+	if strings.TrimSpace(css) != "" {
+		fmt.Fprintf(&buf, "vue.InjectStyle(%q, %sStyle)\n", "vue-style-"+strings.TrimPrefix(scope, "data-v-"), lowerFirst(name))
+	}
+	buf.WriteString("opt := vue.NewOption()\n")
+	fmt.Fprintf(&buf, "opt.Name = %q\n", m.Name)
+	fmt.Fprintf(&buf, "opt.Template = %sTemplate\n", lowerFirst(name))
+	if scoped {
+		// VueJS adds the scope attribute to the elements of the component
+		fmt.Fprintf(&buf, "opt.Set(\"_scopeId\", %q)\n", scope)
+	}
+	if m.Creator != "" {
+		fmt.Fprintf(&buf, "opt.SetDataFactory(%s)\n", m.Creator)
+	}
+	if len(m.Props) > 0 {
+		quoted := make([]string, len(m.Props))
+		for i, p := range m.Props {
+			quoted[i] = strconv.Quote(p)
+		}
+		fmt.Fprintf(&buf, "opt.AddProp(%s)\n", strings.Join(quoted, ", "))
+	}
+	fmt.Fprintf(&buf, "return opt.NewComponent().Register(%q)\n", m.Name)
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestScopeID(t *testing.T) {
+	a := scopeID(filepath.Join("testdata", "a", "todo-item.vue"))
+	b := scopeID(filepath.Join("testdata", "b", "todo-item.vue"))
+	if a == b {
+		t.Errorf("components of the same name in different dirs share the scope %s", a)
+	}
+	if again := scopeID(filepath.Join("testdata", "a", "todo-item.vue")); again != a {
+		t.Errorf("scope of the same file changed from %s to %s", a, again)
+	}
+	abs, _ := filepath.Abs(filepath.Join("testdata", "a", "todo-item.vue"))
+	if scopeID(abs) != a {
+		t.Errorf("relative and absolute paths of the same file got different scopes")
+	}
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// block is a top level block of a `.vue` file
+type block struct {
+	attrs   string
+	content string
+}
+
+// sfc is a parsed single-file component
+type sfc struct {
+	template *block
+	script   *block
+	styles   []*block
+}
+
+// meta is the metadata declared in the `<script>` block as an object
+// literal, the optional `export default` prefix is allowed.
+type meta struct {
+	// Name of the component, defaults to the file name
+	Name string `json:"name"`
+	// Creator is the Go func `func() interface{}` creating the data struct
+	Creator string `json:"creator"`
+	// Props are the props without type declarations
+	Props []string `json:"props"`
+}
+
+// parseSFC splits `src` into its top level blocks
+func parseSFC(src string) (*sfc, error) {
+	f := new(sfc)
+	for {
+		src = skipSpaceAndComments(src)
+		if src == "" {
+			return f, nil
+		}
+		if src[0] != '<' {
+			return nil, fmt.Errorf("unexpected text %q", head(src))
+		}
+		end := strings.IndexByte(src, '>')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed tag %q", head(src))
+		}
+		tag := src[1:end]
+		name := tag
+		attrs := ""
+		if i := strings.IndexAny(tag, " \t\r\n"); i >= 0 {
+			name, attrs = tag[:i], strings.TrimSpace(tag[i:])
+		}
+		content, rest, err := blockContent(src[end+1:], name)
+		if err != nil {
+			return nil, err
+		}
+		b := &block{attrs: attrs, content: content}
+		switch name {
+		case "template":
+			f.template = b
+		case "script":
+			f.script = b
+		case "style":
+			f.styles = append(f.styles, b)
+		default:
+			return nil, fmt.Errorf("unknown block <%s>", name)
+		}
+		src = rest
+	}
+}
+
+// blockContent returns the content till the matching close tag of `name`,
+// nested tags of the same name are allowed, like `<template>` in templates.
+func blockContent(src, name string) (content, rest string, err error) {
+	open, close := "<"+name, "</"+name+">"
+	depth := 1
+	for i := 0; i < len(src); {
+		switch {
+		case strings.HasPrefix(src[i:], close):
+			depth--
+			if depth == 0 {
+				return src[:i], src[i+len(close):], nil
+			}
+			i += len(close)
+		case strings.HasPrefix(src[i:], open) && name != "script" && name != "style" &&
+			len(src) > i+len(open) && strings.IndexByte(" \t\r\n>", src[i+len(open)]) >= 0:
+			depth++
+			i += len(open)
+		default:
+			i++
+		}
+	}
+	return "", "", fmt.Errorf("unclosed block <%s>", name)
+}
+
+func skipSpaceAndComments(src string) string {
+	for {
+		src = strings.TrimSpace(src)
+		if !strings.HasPrefix(src, "<!--") {
+			return src
+		}
+		end := strings.Index(src, "-->")
+		if end < 0 {
+			return ""
+		}
+		src = src[end+3:]
+	}
+}
+
+func head(s string) string {
+	if len(s) > 20 {
+		return s[:20] + "..."
+	}
+	return s
+}
+
+// parseMeta decodes the metadata of the `<script>` block
+func parseMeta(b *block) (*meta, error) {
+	m := new(meta)
+	if b == nil {
+		return m, nil
+	}
+	src := strings.TrimSpace(b.content)
+	src = strings.TrimPrefix(src, "export default")
+	src = strings.TrimSuffix(strings.TrimSpace(src), ";")
+	if strings.TrimSpace(src) == "" {
+		return m, nil
+	}
+	data, err := literalToJSON(src)
+	if err == nil {
+		err = json.Unmarshal(data, m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid script metadata: %v, expect an object literal of strings like\n%s", err, metaExample)
+	}
+	return m, nil
+}
+
+const metaExample = `	export default {
+		name: "todo-item",
+		creator: "NewTodoItem",
+		props: ["todo"]
+	}`
+
+// literalToJSON converts the JavaScript object literal `src` into JSON,
+// unquoted keys, single quoted strings, trailing commas and comments are
+// allowed. Other JavaScript like functions is left as is and fails later.
+func literalToJSON(src string) ([]byte, error) {
+	var out []byte
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				end = len(src) - i
+			}
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed comment")
+			}
+			i += end + 4
+		case c == '"' || c == '\'':
+			s, n, err := unquoteJS(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			quoted, _ := json.Marshal(s)
+			out = append(out, quoted...)
+			i += n
+		case c == '}' || c == ']':
+			// drop the trailing comma
+			trimmed := strings.TrimRight(string(out), " \t\r\n")
+			if strings.HasSuffix(trimmed, ",") {
+				out = append([]byte(trimmed[:len(trimmed)-1]), out[len(trimmed):]...)
+			}
+			out = append(out, c)
+			i++
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(src) && (isIdentStart(src[j]) || src[j] >= '0' && src[j] <= '9') {
+				j++
+			}
+			word := src[i:j]
+			if k := strings.TrimLeft(src[j:], " \t\r\n"); strings.HasPrefix(k, ":") {
+				word = `"` + word + `"`
+			}
+			out = append(out, word...)
+			i = j
+		default:
+			out = append(out, c)
+			i++
+		}
+	}
+	return out, nil
+}
+
+// unquoteJS decodes the JavaScript string literal at the start of `src`,
+// n is the length of the literal.
+func unquoteJS(src string) (s string, n int, err error) {
+	q := src[0]
+	var buf []byte
+	for i := 1; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == q:
+			return string(buf), i + 1, nil
+		case c == '\n':
+			return "", 0, fmt.Errorf("newline in string %s", head(src))
+		case c == '\\' && i+1 < len(src):
+			i++
+			switch e := src[i]; e {
+			case 'n':
+				buf = append(buf, '\n')
+			case 't':
+				buf = append(buf, '\t')
+			case 'r':
+				buf = append(buf, '\r')
+			case 'u', 'x':
+				size := 4
+				if e == 'x' {
+					size = 2
+				}
+				if i+size >= len(src) {
+					return "", 0, fmt.Errorf("invalid escape in string %s", head(src))
+				}
+				r, err := strconv.ParseUint(src[i+1:i+1+size], 16, 32)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid escape in string %s", head(src))
+				}
+				buf = append(buf, string(rune(r))...)
+				i += size
+			default:
+				buf = append(buf, e)
+			}
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return "", 0, fmt.Errorf("unclosed string %s", head(src))
+}
+
+func isIdentStart(c byte) bool {
+	return isLetter(c) || c == '_' || c == '$'
+}
+
+var commentRE = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// scopeCSS appends the scope attribute selector to the last compound
+// selector of every rule, selectors of keyframes are left as is.
+func scopeCSS(css, scope string) string {
+	css = commentRE.ReplaceAllString(css, "")
+	var out strings.Builder
+	var stack []bool // whether the enclosing block is keyframes
+	for {
+		i := strings.IndexAny(css, "{}")
+		if i < 0 {
+			out.WriteString(css)
+			return out.String()
+		}
+		prelude := css[:i]
+		if css[i] == '}' {
+			out.WriteString(prelude + "}")
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			css = css[i+1:]
+			continue
+		}
+		// declarations before a nested rule end with `;`
+		decl := ""
+		if j := strings.LastIndexByte(prelude, ';'); j >= 0 {
+			decl, prelude = prelude[:j+1], prelude[j+1:]
+		}
+		out.WriteString(decl)
+		sel := strings.TrimSpace(prelude)
+		inKeyframes := len(stack) > 0 && stack[len(stack)-1]
+		switch {
+		case strings.HasPrefix(sel, "@"):
+			out.WriteString(prelude)
+			stack = append(stack, strings.Contains(sel, "keyframes"))
+		case inKeyframes:
+			out.WriteString(prelude)
+			stack = append(stack, false)
+		default:
+			parts := strings.Split(sel, ",")
+			for k, p := range parts {
+				parts[k] = scopeSelector(strings.TrimSpace(p), scope)
+			}
+			out.WriteString(strings.Join(parts, ", ") + " ")
+			stack = append(stack, false)
+		}
+		out.WriteString("{")
+		css = css[i+1:]
+	}
+}
+
+func scopeSelector(sel, scope string) string {
+	// the last compound selector starts after the last combinator
+	start := strings.LastIndexAny(sel, " >+~") + 1
+	last := sel[start:]
+	// pseudo classes and elements go after the attribute selector
+	if i := strings.IndexByte(last, ':'); i >= 0 {
+		return sel[:start] + last[:i] + "[" + scope + "]" + last[i:]
+	}
+	return sel + "[" + scope + "]"
+}
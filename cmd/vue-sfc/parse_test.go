@@ -0,0 +1,79 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScopeCSS(t *testing.T) {
+	const scope = "data-v-1"
+	tests := []struct {
+		css, want string
+	}{
+		{`.a { color: red; }`,
+			`.a[data-v-1] { color: red; }`},
+		{`.a .b, ul > li{margin:0}`,
+			`.a .b[data-v-1], ul > li[data-v-1] {margin:0}`},
+		{`a:hover::after { content: "x" }`,
+			`a[data-v-1]:hover::after { content: "x" }`},
+		{`/* .c { } */ .c { }`,
+			`.c[data-v-1] { }`},
+		{`@media (max-width: 600px) { .a { top: 0 } }`,
+			`@media (max-width: 600px) {.a[data-v-1] { top: 0 } }`},
+		{`@keyframes spin { from { opacity: 0 } to { opacity: 1 } }`,
+			`@keyframes spin { from { opacity: 0 } to { opacity: 1 } }`},
+	}
+	for _, tt := range tests {
+		if got := scopeCSS(tt.css, scope); got != tt.want {
+			t.Errorf("scopeCSS(%q)\n got %q\nwant %q", tt.css, got, tt.want)
+		}
+	}
+}
+
+func TestParseMeta(t *testing.T) {
+	tests := []struct {
+		script string
+		want   meta
+	}{
+		{``, meta{}},
+		{`export default {"name": "todo-item", "props": ["item"]}`,
+			meta{Name: "todo-item", Props: []string{"item"}}},
+		{`export default { name: "todo-item", props: ["item"] }`,
+			meta{Name: "todo-item", Props: []string{"item"}}},
+		{`export default {
+			// the data struct
+			name: 'todo-item',
+			creator: 'NewTodoItem', /* in main.go */
+			props: ['item', 'on-done',],
+		};`,
+			meta{Name: "todo-item", Creator: "NewTodoItem", Props: []string{"item", "on-done"}}},
+		{`{ name: 'it\'s "x"!' }`, meta{Name: `it's "x"!`}},
+		{`{ name: "a,}" }`, meta{Name: "a,}"}},
+	}
+	for _, tt := range tests {
+		m, err := parseMeta(&block{content: tt.script})
+		if err != nil {
+			t.Errorf("parseMeta(%q): %v", tt.script, err)
+			continue
+		}
+		if !reflect.DeepEqual(*m, tt.want) {
+			t.Errorf("parseMeta(%q) = %+v, want %+v", tt.script, *m, tt.want)
+		}
+	}
+}
+
+func TestParseMetaInvalid(t *testing.T) {
+	for _, script := range []string{
+		`export default { name: "x", data() { return {} } }`,
+		`export default { name: 'x }`,
+		`module.exports = { name: "x" }`,
+	} {
+		_, err := parseMeta(&block{content: script})
+		if err == nil {
+			t.Errorf("parseMeta(%q) got no error", script)
+		} else if !strings.Contains(err.Error(), metaExample) {
+			t.Errorf("parseMeta(%q) error shows no example: %v", script, err)
+		}
+	}
+}
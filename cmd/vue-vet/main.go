@@ -0,0 +1,18 @@
+// Command vue-vet runs the modelcheck analyzer as a vet tool:
+//
+//	go vet -vettool=$(which vue-vet) ./...
+//
+// see package analysis/modelcheck for the reported problems. It depends on
+// golang.org/x/tools, which should be in the GOPATH:
+//
+//	go get golang.org/x/tools/go/analysis/...
+package main
+
+import (
+	"github.com/oskca/gopherjs-vue/analysis/modelcheck"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(modelcheck.Analyzer)
+}
@@ -4,16 +4,6 @@ import (
 	"github.com/gopherjs/gopherjs/js"
 )
 
-var (
-	creatorPool = make([]*pool, 0)
-)
-
-type pool struct {
-	creator   func() (structPtr interface{})
-	structPtr interface{}
-	counter   int
-}
-
 // Component is actually an Extended Vue SubClass,
 // which acts as a Component constructor in VueJS world
 // thus you can use Component.New to create a
@@ -46,30 +36,17 @@ func GetComponent(name string) *Component {
 // NewComponent creates and registers a named global Component
 //
 //  vmCreator should return a gopherjs struct pointer. see New for more details
+//
+//  vmCreator is called once for every component instance, see
+//  Option.SetDataFactory for details.
 func NewComponent(
 	vmCreator func() (structPtr interface{}),
 	templateStr string,
 	replaceMountPoint ...bool,
 ) *Component {
-	// args
-	idx := len(creatorPool)
-	creatorPool = append(creatorPool, new(pool))
-	creatorPool[idx].creator = vmCreator
-	vmfn := func() interface{} {
-		p := creatorPool[idx]
-		if p.counter%3 == 0 {
-			p.structPtr = p.creator()
-		}
-		p.counter += 1
-		return p.structPtr
-	}
 	// opts
 	opt := NewOption()
-	opt.Data = vmfn
 	opt.Template = templateStr
-	opt.OnLifeCycleEvent(EvtBeforeCreate, func(vm *ViewModel) {
-		vm.Options.Set("methods", js.MakeWrapper(vmfn()))
-		vMap[vmfn()] = vm
-	})
+	opt.SetDataFactory(vmCreator)
 	return opt.NewComponent()
 }
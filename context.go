@@ -0,0 +1,47 @@
+package vue
+
+import (
+	"context"
+)
+
+// contexts maps the `_uid` of VueJS instances to their contexts
+var contexts = make(map[int]context.Context, 0)
+
+// Context returns a context.Context which is cancelled when the instance
+// is destroyed, goroutines started from lifecycle hooks or methods should
+// stop working on the instance when it's done:
+//
+//	func (m *Model) Mounted(vm *vue.ViewModel) {
+//		ctx := vm.Context()
+//		go func() {
+//			ticker := time.NewTicker(time.Second)
+//			defer ticker.Stop()
+//			for {
+//				select {
+//				case <-ctx.Done():
+//					return
+//				case t := <-ticker.C:
+//					m.Now = t.String()
+//				}
+//			}
+//		}()
+//	}
+//
+// The same context is returned for all calls on the same instance.
+func (v *ViewModel) Context() context.Context {
+	uid := v.uid()
+	if ctx, ok := contexts[uid]; ok {
+		return ctx
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if v.Get("_isDestroyed").Bool() {
+		cancel()
+		return ctx
+	}
+	contexts[uid] = ctx
+	v.onDestroyed(func() {
+		cancel()
+		delete(contexts, uid)
+	})
+	return ctx
+}
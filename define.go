@@ -0,0 +1,245 @@
+package vue
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var jsObjectType = reflect.TypeOf((*js.Object)(nil))
+
+// modelType holds the reflection information of a gopherjs struct pointer
+// which has an embeded anonymous `*js.Object` field
+type modelType struct {
+	typ    reflect.Type // the struct type
+	objIdx int          // index of the embeded *js.Object
+}
+
+func newModelType(structPtr interface{}) *modelType {
+	t := reflect.TypeOf(structPtr)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic("vue: " + t.String() + " is not a struct pointer")
+	}
+	m, ok := modelTypeOf(t)
+	if !ok {
+		panic("vue: " + t.Elem().String() + " has no embeded *js.Object")
+	}
+	return m
+}
+
+// modelTypeOf returns the modelType of struct pointer type `t` if the
+// struct has an embeded `*js.Object`
+func modelTypeOf(t reflect.Type) (*modelType, bool) {
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	t = t.Elem()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type == jsObjectType {
+			return &modelType{typ: t, objIdx: i}, true
+		}
+	}
+	return nil, false
+}
+
+// bind creates a new struct pointer backed by `obj`, normally `obj` is the
+// VueJS instance thus all data, props and computed are accessible through
+// the `js struct tag` fields and dependency tracking works as expected.
+func (m *modelType) bind(obj *js.Object) reflect.Value {
+	v := reflect.New(m.typ)
+	v.Elem().Field(m.objIdx).Set(reflect.ValueOf(obj))
+	return v
+}
+
+// method returns the exported method `name` of the struct pointer
+func (m *modelType) method(name string) reflect.Method {
+	fn, ok := reflect.PtrTo(m.typ).MethodByName(name)
+	if !ok {
+		panic("vue: " + m.typ.String() + " has no method " + name)
+	}
+	return fn
+}
+
+// call invokes method `fn` on a struct pointer bound to `this`,
+// `args` are decoded into the argument types of `fn`
+func (m *modelType) call(fn reflect.Method, this *js.Object, args ...*js.Object) []reflect.Value {
+	in := []reflect.Value{m.bind(this)}
+	for i := 1; i < fn.Type.NumIn(); i++ {
+		var arg *js.Object
+		if i-1 < len(args) {
+			arg = args[i-1]
+		}
+		in = append(in, Decode(arg, fn.Type.In(i)))
+	}
+	return fn.Func.Call(in)
+}
+
+// vueTag is the parsed form of the `vue struct tag`
+type vueTag struct {
+	prop     bool
+	required bool
+	computed string
+	watch    string
+}
+
+func parseVueTag(f reflect.StructField) (tag *vueTag, ok bool) {
+	str, ok := f.Tag.Lookup("vue")
+	if !ok {
+		return nil, false
+	}
+	tag = new(vueTag)
+	for _, opt := range strings.Split(str, ",") {
+		opt = strings.TrimSpace(opt)
+		key, val := opt, ""
+		if i := strings.Index(opt, "="); i >= 0 {
+			key, val = opt[:i], opt[i+1:]
+		}
+		switch key {
+		case "prop":
+			tag.prop = true
+		case "required":
+			tag.required = true
+		case "computed":
+			tag.computed = val
+			if val == "" {
+				tag.computed = "Get" + f.Name
+			}
+		case "watch":
+			tag.watch = val
+		default:
+			panic("vue: unknown vue tag option " + opt + " of field " + f.Name)
+		}
+	}
+	return tag, true
+}
+
+// propTypeOf infers the PropType from the Go type of a field
+func propTypeOf(t reflect.Type) []PropType {
+	switch t.Kind() {
+	case reflect.String:
+		return []PropType{PropString}
+	case reflect.Bool:
+		return []PropType{PropBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return []PropType{PropNumber}
+	case reflect.Slice, reflect.Array:
+		return []PropType{PropArray}
+	case reflect.Func:
+		return []PropType{PropFunction}
+	case reflect.Map, reflect.Struct:
+		return []PropType{PropObject}
+	case reflect.Ptr:
+		if t == jsObjectType {
+			return nil
+		}
+		return []PropType{PropObject}
+	}
+	return nil
+}
+
+// Define generates props, computed properties and watchers from
+// the `vue struct tag` of the fields of `structPtr`, the field name used in
+// VueJS is taken from the `js struct tag`.
+//
+//	type Com struct {
+//		*js.Object
+//		Title    string `js:"title" vue:"prop,required"`
+//		Count    int    `js:"count" vue:"watch=CountChanged"`
+//		Double   int    `js:"double" vue:"computed"`
+//		Squared  int    `js:"squared" vue:"computed=Square"`
+//	}
+//
+//	func (c *Com) GetDouble() int { return c.Count * 2 }
+//	func (c *Com) Square() int { return c.Count * c.Count }
+//	func (c *Com) CountChanged(newVal, oldVal int) {}
+//
+// Supported options:
+//
+//  * `prop` declares a typed prop, the type is inferred from the field type,
+//  add `required` to make it a required prop.
+//
+//  * `computed` declares a computed property whose getter is the method
+//  `Get<FieldName>`, or the method given by `computed=Method`. If the method
+//  `Set<FieldName>(val T)` exists it's used as the setter.
+//
+//  * `watch=Method` watches the field, `Method` is called with the new and
+//  old value decoded into its argument types, see Decode.
+//
+// Methods used by computed and watch are invoked on a struct pointer
+// backed by the VueJS instance, so all fields are accessible as usual.
+// Props and computed fields should never be assigned in the data struct.
+func (c *Option) Define(structPtr interface{}) *Option {
+	m := newModelType(structPtr)
+	for i := 0; i < m.typ.NumField(); i++ {
+		f := m.typ.Field(i)
+		tag, ok := parseVueTag(f)
+		if !ok {
+			continue
+		}
+		name := f.Tag.Get("js")
+		if name == "" {
+			panic("vue: field " + f.Name + " has vue tag but no js tag")
+		}
+		if tag.prop {
+			p := NewProp(name, propTypeOf(f.Type)...)
+			p.Required = tag.required
+			c.AddTypedProp(p)
+		}
+		if tag.computed != "" {
+			c.defineComputed(m, name, f.Name, tag.computed)
+		}
+		if tag.watch != "" {
+			c.defineWatch(m, name, tag.watch)
+		}
+	}
+	return c
+}
+
+func (c *Option) defineComputed(m *modelType, name, fieldName, getterName string) {
+	getter := m.method(getterName)
+	conf := js.M{
+		"get": js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			out := m.call(getter, this)
+			if len(out) == 0 {
+				return nil
+			}
+			return out[0].Interface()
+		}),
+	}
+	if setter, ok := reflect.PtrTo(m.typ).MethodByName("Set" + fieldName); ok {
+		conf["set"] = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			m.call(setter, this, arguments...)
+			return nil
+		})
+	}
+	c.addMixin("computed", js.M{name: conf})
+}
+
+func (c *Option) defineWatch(m *modelType, name, handlerName string) {
+	handler := m.method(handlerName)
+	c.addMixin("watch", js.M{
+		name: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			m.call(handler, this, arguments...)
+			return nil
+		}),
+	})
+}
+
+// hasVueTag reports whether any field of `structPtr` has the `vue struct tag`
+func hasVueTag(structPtr interface{}) bool {
+	t := reflect.TypeOf(structPtr)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	t = t.Elem()
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("vue"); ok {
+			return true
+		}
+	}
+	return false
+}
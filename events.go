@@ -0,0 +1,61 @@
+package vue
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Event is an event emitted on the VueJS instance by `vm.$emit`
+type Event struct {
+	// Name of the event
+	Name string
+	// Args are the arguments passed to `vm.$emit` after the name
+	Args []*js.Object
+}
+
+// Arg returns the i-th argument of the event or undefined
+func (e *Event) Arg(i int) *js.Object {
+	if i < len(e.Args) {
+		return e.Args[i]
+	}
+	return js.Undefined
+}
+
+// Events listens for event `name` on the instance and returns a channel of
+// the event payloads, so goroutines can `select` over UI events:
+//
+//	events, release := vm.Events("save")
+//	defer release()
+//	for evt := range events {
+//		save(evt.Arg(0))
+//	}
+//
+// Events are queued without limit thus never dropped. The listener is removed
+// and the channel is closed when `release` is called or
+// the instance is destroyed.
+func (v *ViewModel) Events(name string) (events <-chan *Event, release func()) {
+	ch := make(chan *Event)
+	q := newQueue(nil, func(item interface{}, done <-chan struct{}) bool {
+		select {
+		case ch <- item.(*Event):
+			return true
+		case <-done:
+			return false
+		}
+	}, func() {
+		close(ch)
+	})
+	listener := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		q.push(&Event{
+			Name: name,
+			Args: append([]*js.Object(nil), arguments...),
+		})
+		return nil
+	})
+	v.Call("$on", name, listener)
+	release = func() {
+		v.Call("$off", name, listener)
+		q.close()
+	}
+	v.onDestroyed(release)
+	return ch, release
+}
@@ -28,8 +28,8 @@ func NewTodo(content string) *Todo {
 
 type Model struct {
 	*js.Object                 // this is needed for bidirectional data bindings
-	IntValue     int           `js:"integer"`
-	Str          string        `js:"str"`
+	IntValue     int           `js:"integer" default:"100"`
+	Str          string        `js:"str" default:"a string"`
 	List         []int         `js:"list"`
 	Todos        []*Todo       `js:"todos"`
 	CheckedItems []string      `js:"CheckedItems"`
@@ -67,8 +67,8 @@ func (m *Model) PopulateTodo() {
 func (m *Model) PopulateTodo2() {
 	// so it's better to use VueJS ops to manipulates the array
 	vm := vue.GetVM(m)
-	todos := vm.Get("todos")
-	vue.Push(todos, NewTodo(m.Str))
+	todos := vue.NewReactiveSlice(vm, "todos", []*Todo(nil))
+	todos.Append(NewTodo(m.Str))
 }
 
 func (m *Model) MapTodos() {
@@ -109,16 +109,11 @@ func main() {
 		return t.Format("2006-01-02 15:04:05")
 	}).Register("timeFormat")
 	// begin vm
-	m := &Model{
-		Object: js.Global.Get("Object").New(),
-	}
-	// this is the correct way to initialize the gopherjs struct
-	// which would be used in the JavaScript side.
-	m.IntValue = 100
-	m.Str = "a string"
+	// NewModel allocates the js.Object and applies the default tags,
+	// Todos is initialized as an empty slice
+	m := vue.NewModel(&Model{}).(*Model)
 	m.List = []int{1, 2, 3, 4}
 	// m.Todos = []*Todo{NewTodo("Good Day")}
-	m.Todos = []*Todo{}
 	m.AllItems = []string{"A", "B", "C", "D", "John", "Bill"}
 	m.CheckedItems = []string{"A", "B"}
 	m.Now = func() string {
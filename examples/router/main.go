@@ -0,0 +1,89 @@
+package main
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/oskca/gopherjs-vue"
+	"github.com/oskca/gopherjs-vue/router"
+)
+
+type User struct {
+	*js.Object
+	Visits int `js:"visits"`
+}
+
+func NewUser() interface{} {
+	u := &User{
+		Object: js.Global.Get("Object").New(),
+	}
+	u.Visits = 0
+	return u
+}
+
+// this would be registered as the `created` hook
+func (u *User) Created(vm *vue.ViewModel) {
+	println("user id:", router.GetRoute(vm).Param("id"))
+}
+
+func (u *User) Visit() {
+	u.Visits += 1
+}
+
+type Model struct {
+	*js.Object
+}
+
+func main() {
+	home := vue.NewComponent(func() interface{} {
+		return &Model{Object: js.Global.Get("Object").New()}
+	}, `<div>home</div>`)
+	user := vue.NewComponent(NewUser, `
+    <div>
+        <div>user: {{ $route.params.id }} visits: {{ visits }}</div>
+        <button @click="Visit">visit</button>
+        <router-view></router-view>
+    </div>
+`)
+	profile := vue.NewComponent(func() interface{} {
+		return &Model{Object: js.Global.Get("Object").New()}
+	}, `<div>profile of {{ $route.params.id }}</div>`)
+
+	r := router.New(&router.Options{
+		Mode: router.ModeHash,
+		Routes: []*router.RouteConfig{
+			{Path: "/", Component: home},
+			{
+				Path:      "/user/:id",
+				Component: user,
+				Children: []*router.RouteConfig{
+					{Path: "profile", Component: profile},
+				},
+			},
+			{
+				Path: "/admin",
+				BeforeEnter: func(to, from *router.Route, next *router.Next) {
+					next.Redirect("/")
+				},
+			},
+		},
+	})
+	r.BeforeEach(func(to, from *router.Route, next *router.Next) {
+		println("navigating to:", to.FullPath)
+		next.Continue()
+	})
+	r.AfterEach(func(to, from *router.Route) {
+		println("navigated to:", to.FullPath)
+	})
+
+	m := &Model{
+		Object: js.Global.Get("Object").New(),
+	}
+	o := vue.NewOption()
+	o.SetDataWithMethods(m)
+	o.AddMethod("GoHome", func(vm *vue.ViewModel, args []*js.Object) {
+		router.GetRouter(vm).Push("/")
+	})
+	r.Apply(o)
+	v := o.NewViewModel()
+	v.Mount("#app")
+	js.Global.Set("vm", v)
+}
@@ -107,7 +107,8 @@ func Mixin(mixin interface{}) {
 // 	{string} template
 // 	Usage:
 //
-// Compiles a template string into a render function. Only available in the standalone build.
+// Compiles a template string into a render function. Only available in the standalone build,
+// thus not available with the `runtime` building tag.
 func Compile(template string) (renderFn *js.Object) {
 	return vue.Call("compile", template).Get("render")
 }
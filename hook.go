@@ -0,0 +1,139 @@
+package vue
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Lifecycle hook interfaces, the data struct of New and NewComponent
+// implementing any of them would get the method registered as
+// the coresponding lifecycle hook automatically.
+//
+// Hook methods are never exposed as VueJS instance methods.
+type (
+	BeforeCreateHook  interface{ BeforeCreate(vm *ViewModel) }
+	CreatedHook       interface{ Created(vm *ViewModel) }
+	BeforeMountHook   interface{ BeforeMount(vm *ViewModel) }
+	MountedHook       interface{ Mounted(vm *ViewModel) }
+	BeforeUpdateHook  interface{ BeforeUpdate(vm *ViewModel) }
+	UpdatedHook       interface{ Updated(vm *ViewModel) }
+	ActivatedHook     interface{ Activated(vm *ViewModel) }
+	DeactivatedHook   interface{ Deactivated(vm *ViewModel) }
+	BeforeDestroyHook interface{ BeforeDestroy(vm *ViewModel) }
+	DestroyedHook     interface{ Destroyed(vm *ViewModel) }
+)
+
+type lifeCycleHook struct {
+	evt  LifeCycleEvent
+	name string
+	// method returns the hook method of structPtr or nil
+	method func(structPtr interface{}) func(vm *ViewModel)
+}
+
+var lifeCycleHooks = []lifeCycleHook{
+	{EvtBeforeCreate, "BeforeCreate", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(BeforeCreateHook); ok {
+			return h.BeforeCreate
+		}
+		return nil
+	}},
+	{EvtCreated, "Created", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(CreatedHook); ok {
+			return h.Created
+		}
+		return nil
+	}},
+	{EvtBeforeMount, "BeforeMount", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(BeforeMountHook); ok {
+			return h.BeforeMount
+		}
+		return nil
+	}},
+	{EvtMounted, "Mounted", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(MountedHook); ok {
+			return h.Mounted
+		}
+		return nil
+	}},
+	{EvtBeforeUpdate, "BeforeUpdate", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(BeforeUpdateHook); ok {
+			return h.BeforeUpdate
+		}
+		return nil
+	}},
+	{EvtUpdated, "Updated", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(UpdatedHook); ok {
+			return h.Updated
+		}
+		return nil
+	}},
+	{EvtActivated, "Activated", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(ActivatedHook); ok {
+			return h.Activated
+		}
+		return nil
+	}},
+	{EvtDeactivated, "Deactivated", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(DeactivatedHook); ok {
+			return h.Deactivated
+		}
+		return nil
+	}},
+	{EvtBeforeDestroy, "BeforeDestroy", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(BeforeDestroyHook); ok {
+			return h.BeforeDestroy
+		}
+		return nil
+	}},
+	{EvtDestroyed, "Destroyed", func(p interface{}) func(vm *ViewModel) {
+		if h, ok := p.(DestroyedHook); ok {
+			return h.Destroyed
+		}
+		return nil
+	}},
+}
+
+// addLifeCycleHooks registers the hook methods implemented by `sample`,
+// `structOf` returns the struct pointer bound to the instance being called.
+func (c *Option) addLifeCycleHooks(sample interface{}, structOf func(vm *ViewModel) interface{}) *Option {
+	for _, h := range lifeCycleHooks {
+		if h.method(sample) == nil {
+			continue
+		}
+		h := h
+		c.OnLifeCycleEvent(h.evt, func(vm *ViewModel) {
+			if fn := h.method(structOf(vm)); fn != nil {
+				fn(vm)
+			}
+		})
+	}
+	return c
+}
+
+// makeMethods wraps the exported methods of structPtr by js.MakeWrapper
+// leaving out the lifecycle hook methods, methods returning (T, error)
+// are exposed as functions returning Promise.
+func makeMethods(structPtr interface{}) *js.Object {
+	methods := js.MakeWrapper(structPtr)
+	for _, h := range lifeCycleHooks {
+		if h.method(structPtr) != nil {
+			methods.Delete(h.name)
+		}
+	}
+	v := reflect.ValueOf(structPtr)
+	for i := 0; i < v.NumMethod(); i++ {
+		name := v.Type().Method(i).Name
+		if methods.Get(name) == js.Undefined || !isAsyncMethod(v.Method(i).Type()) {
+			continue
+		}
+		methods.Set(name, makePromiseFunc(v.Method(i)))
+	}
+	return methods
+}
+
+// onDestroyed calls fn once the instance is destroyed, it's the `destroyed`
+// hook of a single instance using the `hook:destroyed` event.
+func (v *ViewModel) onDestroyed(fn func()) {
+	v.Once("hook:destroyed", fn)
+}
@@ -0,0 +1,5 @@
+//+build runtime
+
+package vue
+
+import _ "github.com/oskca/gopherjs-vue/jscode/runtime"
@@ -0,0 +1,66 @@
+package vue
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// provided maps the `_uid` of VueJS instances to the values they provide
+var provided = make(map[int]map[string]interface{}, 0)
+
+// Provide makes `value` injectable by all descendants of the instance under
+// `key`, `value` is kept as a Go value thus can be anything like services
+// or channels.
+func (v *ViewModel) Provide(key string, value interface{}) *ViewModel {
+	uid := v.uid()
+	values, ok := provided[uid]
+	if !ok {
+		values = make(map[string]interface{}, 0)
+		provided[uid] = values
+		v.onDestroyed(func() {
+			delete(provided, uid)
+		})
+	}
+	values[key] = value
+	return v
+}
+
+// Inject resolves the value provided under `key` by the nearest ancestor
+func (v *ViewModel) Inject(key string) (value interface{}, ok bool) {
+	for p := v.Parent; p != nil && p != js.Undefined; p = p.Get("$parent") {
+		if values, found := provided[p.Get("_uid").Int()]; found {
+			if value, ok = values[key]; ok {
+				return value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Provide makes `value` injectable by all descendants of every instance
+// created from the Option, see ViewModel.Provide.
+func (o *Option) Provide(key string, value interface{}) *Option {
+	return o.OnLifeCycleEvent(EvtBeforeCreate, func(vm *ViewModel) {
+		vm.Provide(key, value)
+	})
+}
+
+// ProvideFunc is like Provide but the value is created by `fn`
+// for every instance after its data is ready.
+func (o *Option) ProvideFunc(key string, fn func(vm *ViewModel) interface{}) *Option {
+	return o.OnLifeCycleEvent(EvtCreated, func(vm *ViewModel) {
+		vm.Provide(key, fn(vm))
+	})
+}
+
+// Inject declares the keys every instance created from the Option expects
+// to be provided by its ancestors, a warning is logged for the missing ones.
+// The values are resolved by ViewModel.Inject.
+func (o *Option) Inject(keys ...string) *Option {
+	return o.OnLifeCycleEvent(EvtBeforeCreate, func(vm *ViewModel) {
+		for _, key := range keys {
+			if _, ok := vm.Inject(key); !ok {
+				js.Global.Get("console").Call("warn", "vue: injection \""+key+"\" not found")
+			}
+		}
+	})
+}
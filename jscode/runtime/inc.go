@@ -0,0 +1,4 @@
+// Package runtime embeds the runtime only build of VueJS, it's the
+// debug build with the template compiler stripped, so templates must be
+// precompiled by cmd/vue-precompile.
+package runtime
@@ -0,0 +1,121 @@
+package vue
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ReactiveMap is a value typed view of an object field of a VueJS
+// instance used as a map with dynamic keys, keys are added and deleted by
+// Vue.set and Vue.delete thus views are updated as Go map fields never do:
+//
+//	type Model struct {
+//		*js.Object
+//		Users map[string]*User `js:"users"`
+//	}
+//
+//	users := vue.NewReactiveMap(vm, "users", map[string]*User(nil))
+//	users.Set("bob", NewUser("Bob"))
+//	users.Range(func(id string, u *User) bool {
+//		println(id, u.Name)
+//		return true
+//	})
+//
+// The field must exist in the data of the instance before it's created,
+// initialize it as an empty map like NewModel does. Templates iterate it
+// as any object:
+//
+//	<li v-for="(user, id) in users">{{ id }}: {{ user.name }}</li>
+type ReactiveMap struct {
+	vm    *ViewModel
+	field string
+	typ   reflect.Type // the map type
+}
+
+// NewReactiveMap creates a ReactiveMap of the object `field` of `vm`,
+// `mapType` is any value of the Go map type like `map[string]*User(nil)`,
+// whose key type must be of the string kind.
+func NewReactiveMap(vm *ViewModel, field string, mapType interface{}) *ReactiveMap {
+	t := reflect.TypeOf(mapType)
+	if t == nil || t.Kind() != reflect.Map || t.Key().Kind() != reflect.String {
+		panic("vue: NewReactiveMap needs a string keyed map type, got " + typeString(t))
+	}
+	return &ReactiveMap{
+		vm:    vm,
+		field: field,
+		typ:   t,
+	}
+}
+
+// object returns the current value of the field, assigning a new map
+// to the field makes VueJS observe the new object.
+func (m *ReactiveMap) object() *js.Object {
+	obj := m.vm.Get(m.field)
+	if obj == nil || obj == js.Undefined {
+		panic("vue: field " + m.field + " is not an object")
+	}
+	return obj
+}
+
+// Set sets the value of `key`, adding the key if it does not exist
+func (m *ReactiveMap) Set(key string, val interface{}) {
+	if !assignable(val, m.typ.Elem()) {
+		panic("vue: " + typeString(reflect.TypeOf(val)) + " can not be used as " +
+			m.typ.Elem().String() + " of field " + m.field)
+	}
+	Set(m.object(), key, val)
+}
+
+// Get returns the value of `key` decoded into the value type,
+// `ok` is false if the key does not exist.
+func (m *ReactiveMap) Get(key string) (val interface{}, ok bool) {
+	obj := m.object()
+	if !obj.Call("hasOwnProperty", key).Bool() {
+		return reflect.Zero(m.typ.Elem()).Interface(), false
+	}
+	return Decode(obj.Get(key), m.typ.Elem()).Interface(), true
+}
+
+// Delete removes `key`, it's a no-op if the key does not exist
+func (m *ReactiveMap) Delete(key string) {
+	Delete(m.object(), key)
+}
+
+// Keys returns the keys in the iteration order of JavaScript
+func (m *ReactiveMap) Keys() []string {
+	return js.Keys(m.object())
+}
+
+// Len returns the number of keys
+func (m *ReactiveMap) Len() int {
+	return len(m.Keys())
+}
+
+// Range calls `fn` for each key and value until it returns false, the form
+// of `fn` is `func(key K, val V) bool` where K and V are the key and value
+// types. Keys are visited in the iteration order of JavaScript.
+func (m *ReactiveMap) Range(fn interface{}) {
+	f := reflect.ValueOf(fn)
+	t := f.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 1 ||
+		t.In(0) != m.typ.Key() || t.In(1) != m.typ.Elem() || t.Out(0).Kind() != reflect.Bool {
+		panic("vue: invalid func " + t.String() + " for entries of " + m.typ.String())
+	}
+	obj := m.object()
+	for _, key := range js.Keys(obj) {
+		in := []reflect.Value{
+			reflect.ValueOf(key).Convert(m.typ.Key()),
+			Decode(obj.Get(key), m.typ.Elem()),
+		}
+		if !f.Call(in)[0].Bool() {
+			return
+		}
+	}
+}
+
+// ToMap converts the object into a Go map of the map type,
+// values of gopherjs structs are still backed by the object values.
+func (m *ReactiveMap) ToMap() interface{} {
+	return Decode(m.object(), m.typ).Interface()
+}
@@ -1,6 +1,7 @@
 package vue
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/gopherjs/gopherjs/js"
@@ -39,3 +40,69 @@ func (v *ViewModel) ToJS() *js.Object {
 func (v *ViewModel) ToJSON() string {
 	return json.Stringify(v.ToJSON())
 }
+
+// Decode converts obj into a Go value of type t, it's used to deliver
+// JavaScript values to typed Go callbacks. Supported types are:
+//
+//  * *js.Object and interface{}, obj is passed through as is
+//
+//  * bool, string and all numeric types
+//
+//  * pointers of gopherjs struct with an embeded *js.Object, the result is
+//  backed by obj thus changes are visible for both sides
+//
+//  * slices and string keyed maps of supported types
+func Decode(obj *js.Object, t reflect.Type) reflect.Value {
+	if t == jsObjectType {
+		return reflect.ValueOf(obj)
+	}
+	if obj == nil || obj == js.Undefined {
+		return reflect.Zero(t)
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		if i := obj.Interface(); i != nil {
+			return reflect.ValueOf(i).Convert(t)
+		}
+		return reflect.Zero(t)
+	case reflect.Bool:
+		return reflect.ValueOf(obj.Bool()).Convert(t)
+	case reflect.String:
+		return reflect.ValueOf(obj.String()).Convert(t)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(obj.Int64()).Convert(t)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.ValueOf(obj.Uint64()).Convert(t)
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(obj.Float()).Convert(t)
+	case reflect.Slice:
+		n := obj.Length()
+		s := reflect.MakeSlice(t, n, n)
+		for i := 0; i < n; i++ {
+			s.Index(i).Set(Decode(obj.Index(i), t.Elem()))
+		}
+		return s
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			break
+		}
+		m := reflect.MakeMap(t)
+		for _, key := range js.Keys(obj) {
+			m.SetMapIndex(reflect.ValueOf(key).Convert(t.Key()), Decode(obj.Get(key), t.Elem()))
+		}
+		return m
+	case reflect.Ptr:
+		if t.Elem().Kind() != reflect.Struct {
+			break
+		}
+		for i := 0; i < t.Elem().NumField(); i++ {
+			f := t.Elem().Field(i)
+			if f.Anonymous && f.Type == jsObjectType {
+				v := reflect.New(t.Elem())
+				v.Elem().Field(i).Set(reflect.ValueOf(obj))
+				return v
+			}
+		}
+	}
+	panic("vue: can not decode JavaScript value into " + t.String())
+}
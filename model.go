@@ -0,0 +1,184 @@
+package vue
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// NewModel initializes the gopherjs struct pointer `structPtr` and returns
+// it, so the boilerplate of allocating the embeded `*js.Object` is not
+// needed anymore:
+//
+//	type Model struct {
+//		*js.Object
+//		Str   string  `js:"str" default:"hello"`
+//		Count int     `js:"count" default:"10"`
+//		Todos []*Todo `js:"todos"`
+//		Owner *User   `js:"owner"`
+//	}
+//
+//	m := vue.NewModel(&Model{}).(*Model)
+//
+// The initialization is done as:
+//
+//  * the embeded `*js.Object` is allocated if it's nil.
+//
+//  * `js struct tag` fields of string, bool and number kinds undefined in
+//  JavaScript are set to the value of the `default struct tag`, or their
+//  zero values so they are always defined, values of an already allocated
+//  `*js.Object` are kept.
+//
+//  * nil slices and maps are set to empty ones, thus they are observed as
+//  arrays and objects by VueJS.
+//
+//  * fields declared as props or computed properties by the `vue struct tag`
+//  are left untouched, see Option.Define.
+//
+//  * pointers to structs embeding `*js.Object` and the elements of slices
+//  of them are initialized recursively, so objects partially populated on
+//  the JavaScript side get the defaults too. Nil pointers are allocated,
+//  except for the ones referring to a struct type being initialized to
+//  avoid infinite recursion.
+//
+// NewModel panics if `structPtr` is not a pointer to a struct embeding
+// `*js.Object` or any `default struct tag` is invalid.
+func NewModel(structPtr interface{}) interface{} {
+	m := newModelType(structPtr)
+	initModel(reflect.ValueOf(structPtr), m, &modelInit{
+		initializing: map[reflect.Type]int{},
+	})
+	return structPtr
+}
+
+// modelInit is the state of a NewModel call
+type modelInit struct {
+	// initializing counts the models of each type being initialized,
+	// nil pointers to them are not allocated to avoid infinite recursion
+	initializing map[reflect.Type]int
+	// done are the objects initialized, models referring to each other
+	// are initialized only once
+	done []*js.Object
+}
+
+// visit reports whether o is not initialized yet and marks it done
+func (mi *modelInit) visit(o *js.Object) bool {
+	for _, d := range mi.done {
+		if d == o {
+			return false
+		}
+	}
+	mi.done = append(mi.done, o)
+	return true
+}
+
+func initModel(ptr reflect.Value, m *modelType, mi *modelInit) {
+	v := ptr.Elem()
+	obj := v.Field(m.objIdx)
+	if obj.IsNil() {
+		obj.Set(reflect.ValueOf(js.Global.Get("Object").New()))
+	}
+	o := obj.Interface().(*js.Object)
+	if !mi.visit(o) {
+		return
+	}
+	mi.initializing[m.typ]++
+	defer func() {
+		mi.initializing[m.typ]--
+	}()
+	for i := 0; i < m.typ.NumField(); i++ {
+		f := m.typ.Field(i)
+		name := f.Tag.Get("js")
+		if i == m.objIdx || name == "" {
+			continue
+		}
+		// props and computed properties are not data
+		if tag, ok := parseVueTag(f); ok && (tag.prop || tag.computed != "") {
+			continue
+		}
+		field := v.Field(i)
+		// values already in the object are kept
+		undefined := o.Get(name) == js.Undefined
+		if def, ok := f.Tag.Lookup("default"); ok {
+			// parsed anyway so invalid tags always panic
+			val := parseDefault(f, def)
+			if undefined {
+				field.Set(val)
+			}
+			continue
+		}
+		switch f.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if undefined {
+				field.Set(reflect.Zero(f.Type))
+			}
+		case reflect.Slice:
+			if field.IsNil() {
+				field.Set(reflect.MakeSlice(f.Type, 0, 0))
+				continue
+			}
+			if nested, ok := modelTypeOf(f.Type.Elem()); ok {
+				for j := 0; j < field.Len(); j++ {
+					if elem := field.Index(j); !elem.IsNil() {
+						initModel(elem, nested, mi)
+					}
+				}
+			}
+		case reflect.Map:
+			if field.IsNil() {
+				field.Set(reflect.MakeMap(f.Type))
+			}
+		case reflect.Ptr:
+			nested, ok := modelTypeOf(f.Type)
+			if !ok {
+				continue
+			}
+			if !field.IsNil() {
+				initModel(field, nested, mi)
+				continue
+			}
+			if mi.initializing[nested.typ] > 0 {
+				continue
+			}
+			p := reflect.New(nested.typ)
+			initModel(p, nested, mi)
+			field.Set(p)
+		}
+	}
+}
+
+// parseDefault converts the `default struct tag` into the field type
+func parseDefault(f reflect.StructField, def string) reflect.Value {
+	v := reflect.New(f.Type).Elem()
+	var err error
+	switch f.Type.Kind() {
+	case reflect.String:
+		v.SetString(def)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(def)
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		n, err = strconv.ParseInt(def, 0, f.Type.Bits())
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		n, err = strconv.ParseUint(def, 0, f.Type.Bits())
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		n, err = strconv.ParseFloat(def, f.Type.Bits())
+		v.SetFloat(n)
+	default:
+		panic("vue: default tag is not supported for field " + f.Name + " of type " + f.Type.String())
+	}
+	if err != nil {
+		panic("vue: invalid default tag of field " + f.Name + ": " + err.Error())
+	}
+	return v
+}
@@ -0,0 +1,104 @@
+//+build js
+
+package vue
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type definedModel struct {
+	*js.Object
+	Title   string `js:"title" vue:"prop"`
+	Total   int    `js:"total" vue:"computed"`
+	Count   int    `js:"count" vue:"watch=OnCount" default:"1"`
+	Comment string `js:"comment"`
+}
+
+func (m *definedModel) GetTotal() int   { return m.Count }
+func (m *definedModel) OnCount(val int) {}
+
+func TestNewModelSkipsPropsAndComputed(t *testing.T) {
+	m := NewModel(&definedModel{}).(*definedModel)
+	for _, name := range []string{"title", "total"} {
+		if m.Get(name) != js.Undefined {
+			t.Errorf("%s is set in the data object", name)
+		}
+	}
+	if m.Count != 1 {
+		t.Errorf("watched field count = %d, want the default 1", m.Count)
+	}
+	if m.Get("comment") == js.Undefined {
+		t.Error("comment is not initialized")
+	}
+}
+
+type ownerModel struct {
+	*js.Object
+	Name string `js:"name" default:"nobody"`
+	Age  int    `js:"age" default:"18"`
+}
+
+type todoModel struct {
+	*js.Object
+	Text string       `js:"text"`
+	Done bool         `js:"done" default:"true"`
+	Sub  []*todoModel `js:"sub"`
+}
+
+type listModel struct {
+	*js.Object
+	Owner *ownerModel  `js:"owner"`
+	Todos []*todoModel `js:"todos"`
+	Self  *listModel   `js:"self"`
+}
+
+func TestNewModelNestedObject(t *testing.T) {
+	obj := js.Global.Get("Object").New()
+	owner := js.Global.Get("Object").New()
+	owner.Set("name", "john")
+	obj.Set("owner", owner)
+	m := NewModel(&listModel{Object: obj}).(*listModel)
+	if m.Owner.Object != owner {
+		t.Fatal("the existing owner object is replaced")
+	}
+	if m.Owner.Name != "john" || m.Owner.Age != 18 {
+		t.Errorf("owner = %s, %d, want john, 18", m.Owner.Name, m.Owner.Age)
+	}
+}
+
+func TestNewModelSliceElements(t *testing.T) {
+	obj := js.Global.Get("Object").New()
+	obj.Set("todos", []interface{}{
+		js.M{"text": "a"},
+		nil,
+		js.M{"text": "b", "done": false, "sub": []interface{}{js.M{"text": "c"}}},
+	})
+	m := NewModel(&listModel{Object: obj}).(*listModel)
+	todos := obj.Get("todos")
+	if todos.Length() != 3 {
+		t.Fatalf("got %d todos, want 3", todos.Length())
+	}
+	if !m.Todos[0].Done || m.Todos[0].Sub == nil {
+		t.Error("the first todo is not initialized")
+	}
+	if m.Todos[1] != nil {
+		t.Error("nil element is allocated")
+	}
+	if m.Todos[2].Done {
+		t.Error("done of the second todo is overwritten by the default")
+	}
+	if sub := m.Todos[2].Sub; len(sub) != 1 || !sub[0].Done {
+		t.Error("nested todo is not initialized")
+	}
+}
+
+func TestNewModelCycle(t *testing.T) {
+	obj := js.Global.Get("Object").New()
+	obj.Set("self", obj)
+	m := NewModel(&listModel{Object: obj}).(*listModel)
+	if m.Self.Object != obj || m.Owner == nil || m.Owner.Age != 18 {
+		t.Error("model referring to itself is not initialized")
+	}
+}
@@ -150,6 +150,10 @@ type Option struct {
 	coms map[string]*Component
 	// properties
 	props []string
+	// typed properties
+	typedProps []*Prop
+	// prop validation error handler
+	propErrorHandler func(vm *ViewModel, err error)
 	// mixins
 	mixins []js.M
 }
@@ -182,28 +186,86 @@ func (c *Option) prepare() (opts *js.Object) {
 	if len(c.coms) > 0 {
 		c.Set("components", c.coms)
 	}
-	if len(c.props) > 0 {
+	if len(c.typedProps) > 0 {
+		// Object-based syntax, bare names accept any type
+		props := js.M{}
+		for _, name := range c.props {
+			props[name] = nil
+		}
+		for _, p := range c.typedProps {
+			props[p.Name] = p.toJS()
+		}
+		c.Set("props", props)
+	} else if len(c.props) > 0 {
 		c.Set("props", c.props)
 	}
 	if len(c.mixins) > 0 {
 		c.Set("mixins", c.mixins)
 	}
+	c.usePrecompiled()
 	return c.Object
 }
 
 // SetDataWithMethods set data and methods of the genereated VueJS instance
-// based on `structPtr` and `js.MakeWrapper(structPtr)`
+// based on `structPtr` and `js.MakeWrapper(structPtr)`, lifecycle hook
+// methods are left out from the methods.
 func (c *Option) SetDataWithMethods(structPtr interface{}) *Option {
 	if structPtr == nil {
 		return c
 	}
 	c.Set("data", structPtr)
-	c.Set("methods", js.MakeWrapper(structPtr))
+	c.Set("methods", makeMethods(structPtr))
 	return c
 }
 
+// SetDataFactory set data and methods of every VueJS instance created from
+// the Option based on a new struct pointer returned by `creator`, this is
+// the way to define data for components since each instance must have
+// its own data.
+//
+// The struct pointer is created in the `beforeCreate` hook thus is bound
+// to the instance from then on, `vue.GetVM(structPtr)` works in its
+// methods and lifecycle hooks until the instance is destroyed.
+//
+// `creator` is also called once here to get the struct type, the props,
+// computed properties, watchers and lifecycle hooks it declares are
+// added to the Option, see New for details.
+func (c *Option) SetDataFactory(creator func() (structPtr interface{})) *Option {
+	c.OnLifeCycleEvent(EvtBeforeCreate, func(vm *ViewModel) {
+		structPtr := creator()
+		vm.Options.Set("methods", mergeMethods(vm.Options.Get("methods"), makeMethods(structPtr)))
+		bindStruct(structPtr, vm)
+	})
+	c.Data = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		return lookupStruct(newViewModel(this))
+	})
+	// the sample is used for type information only
+	sample := creator()
+	if hasVueTag(sample) {
+		c.Define(sample)
+	}
+	return c.addLifeCycleHooks(sample, lookupStruct)
+}
+
+// mergeMethods returns a new methods object of `merged` overridden by
+// `methods`, `merged` is the `methods` option merged from mixins which is
+// shared by all instances thus never modified.
+func mergeMethods(merged, methods *js.Object) *js.Object {
+	obj := js.Global.Get("Object").New()
+	if merged != js.Undefined && merged != nil {
+		for _, name := range js.Keys(merged) {
+			obj.Set(name, merged.Get(name))
+		}
+	}
+	for _, name := range js.Keys(methods) {
+		obj.Set(name, methods.Get(name))
+	}
+	return obj
+}
+
 // AddMethod adds new method `name` to VueJS intance or component
-// using mixins thus will never conflict with Option.SetDataWithMethods
+// using mixins, methods of the data struct set by SetDataWithMethods
+// or SetDataFactory take precedence over it with the same name.
 func (o *Option) AddMethod(name string, fn func(vm *ViewModel, args []*js.Object)) *Option {
 	return o.addMixin("methods", js.M{
 		name: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
@@ -214,18 +276,23 @@ func (o *Option) AddMethod(name string, fn func(vm *ViewModel, args []*js.Object
 	})
 }
 
+// CreateElement is the `createElement` argument of VueJS render functions
 type CreateElement func(tagName string, data interface{}, children []interface{}) (vnode *js.Object)
-type Render func(vm *ViewModel, fn CreateElement)
 
+// Render is the VueJS render function, it should return the root vnode
+// created by `fn`. See Option.SetRenderFunc for a typed version.
+type Render func(vm *ViewModel, fn CreateElement) (vnode *js.Object)
+
+// SetRender set the render function of the VueJS instance or component
+// which takes priority over the template.
 func (o *Option) SetRender(r Render) {
 	fn := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
 		vm := newViewModel(this)
 		jsCreateElement := arguments[0]
 		createElement := func(tagName string, data interface{}, children []interface{}) (vnode *js.Object) {
-			return jsCreateElement.Call(tagName, data, children)
+			return jsCreateElement.Invoke(tagName, data, children)
 		}
-		r(vm, createElement)
-		return nil
+		return r(vm, createElement)
 	})
 	o.Object.Set("render", fn)
 }
@@ -293,7 +360,8 @@ func (c *Option) AddSubComponent(name string, sub *Component) *Option {
 // 	props is a list/hash of attributes that are exposed to accept data from
 // 	the parent component. It has a simple Array-based syntax and
 // 	an alternative Object-based syntax that allows advanced configurations
-// 	such as type checking, custom validation and default values.
+// 	such as type checking, custom validation and default values,
+// 	see Option.AddTypedProp for the later one.
 func (c *Option) AddProp(name ...string) *Option {
 	c.props = append(c.props, name...)
 	return c
@@ -0,0 +1,30 @@
+package vue
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// precompiledKey is the global object holding the render functions
+// generated by cmd/vue-precompile, keyed by the template strings
+const precompiledKey = "$gopherjsVueTemplates"
+
+// usePrecompiled replaces the string template of the options with its
+// precompiled render functions if there are, thus no template is compiled
+// at runtime which needs `new Function` and breaks under strict CSP.
+func (c *Option) usePrecompiled() {
+	tpl := c.Get("template")
+	if tpl == js.Undefined || c.Get("render") != js.Undefined {
+		return
+	}
+	templates := js.Global.Get(precompiledKey)
+	if templates == js.Undefined {
+		return
+	}
+	compiled := templates.Get(tpl.String())
+	if compiled == js.Undefined {
+		return
+	}
+	c.Set("render", compiled.Get("render"))
+	c.Set("staticRenderFns", compiled.Get("staticRenderFns"))
+	c.Delete("template")
+}
@@ -0,0 +1,112 @@
+package vue
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// NewPromise creates a JavaScript Promise settled by `fn` which runs in
+// a new goroutine thus may block, the Promise resolves with the result of
+// `fn` or rejects with an Error carrying the message of the returned error.
+// A panic in `fn` rejects the Promise too.
+func NewPromise(fn func() (interface{}, error)) *js.Object {
+	return js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					reject.Invoke(js.Global.Get("Error").New(fmt.Sprint(r)))
+				}
+			}()
+			val, err := fn()
+			if err != nil {
+				reject.Invoke(js.Global.Get("Error").New(err.Error()))
+				return
+			}
+			resolve.Invoke(val)
+		}()
+	})
+}
+
+// Await blocks the calling goroutine until `promise` is settled, it returns
+// the resolved value or a *js.Error wrapping the rejection reason.
+// It must not be called from JavaScript callbacks directly.
+func Await(promise *js.Object) (*js.Object, error) {
+	type result struct {
+		val *js.Object
+		err error
+	}
+	ch := make(chan result, 1)
+	promise.Call("then", func(val *js.Object) {
+		ch <- result{val: val}
+	}, func(reason *js.Object) {
+		ch <- result{err: &js.Error{Object: reason}}
+	})
+	r := <-ch
+	return r.val, r.err
+}
+
+// isAsyncMethod reports whether a method of type t returns (T, error)
+func isAsyncMethod(t reflect.Type) bool {
+	return t.NumOut() == 2 && t.Out(1) == errorType
+}
+
+// makePromiseFunc exposes the Go func `fn` returning (T, error) as
+// a JavaScript function returning a Promise, the arguments are decoded
+// into the argument types of `fn`.
+func makePromiseFunc(fn reflect.Value) *js.Object {
+	t := fn.Type()
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		in := decodeArgs(t, arguments)
+		return NewPromise(func() (interface{}, error) {
+			var out []reflect.Value
+			if t.IsVariadic() {
+				out = fn.CallSlice(in)
+			} else {
+				out = fn.Call(in)
+			}
+			err, _ := out[1].Interface().(error)
+			return out[0].Interface(), err
+		})
+	})
+}
+
+// decodeArgs decodes the JavaScript `arguments` into the argument types of
+// the func type t, the trailing arguments of a variadic func are collected
+// into the slice of its last argument.
+func decodeArgs(t reflect.Type, arguments []*js.Object) []reflect.Value {
+	in := make([]reflect.Value, t.NumIn())
+	for i := range in {
+		if t.IsVariadic() && i == len(in)-1 {
+			rest := reflect.MakeSlice(t.In(i), 0, 0)
+			for j := i; j < len(arguments); j++ {
+				rest = reflect.Append(rest, Decode(arguments[j], t.In(i).Elem()))
+			}
+			in[i] = rest
+			break
+		}
+		var arg *js.Object
+		if i < len(arguments) {
+			arg = arguments[i]
+		}
+		in[i] = Decode(arg, t.In(i))
+	}
+	return in
+}
+
+// AddAsyncMethod adds new method `name` returning a Promise to VueJS
+// intance or component, `fn` runs in a new goroutine thus may block,
+// see NewPromise for details.
+func (o *Option) AddAsyncMethod(name string, fn func(vm *ViewModel, args []*js.Object) (interface{}, error)) *Option {
+	return o.addMixin("methods", js.M{
+		name: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			vm := newViewModel(this)
+			return NewPromise(func() (interface{}, error) {
+				return fn(vm, arguments)
+			})
+		}),
+	})
+}
@@ -0,0 +1,61 @@
+//+build js
+
+package vue
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type loader struct {
+	*js.Object
+}
+
+func (l *loader) Get(id string) (string, error) {
+	if id == "" {
+		return "", errors.New("empty id")
+	}
+	return "item " + id, nil
+}
+
+func (l *loader) Load(prefix string, ids ...string) (string, error) {
+	return prefix + strings.Join(ids, ","), nil
+}
+
+func callPromiseFunc(t *testing.T, method string, args ...interface{}) (*js.Object, error) {
+	fn := reflect.ValueOf(&loader{}).MethodByName(method)
+	if !isAsyncMethod(fn.Type()) {
+		t.Fatalf("%s is not an async method", method)
+	}
+	return Await(makePromiseFunc(fn).Invoke(args...))
+}
+
+func TestPromiseFunc(t *testing.T) {
+	val, err := callPromiseFunc(t, "Get", "1")
+	if err != nil || val.String() != "item 1" {
+		t.Errorf("Get(1) = %v, %v", val, err)
+	}
+	if _, err := callPromiseFunc(t, "Get", ""); err == nil {
+		t.Error("Get() is not rejected")
+	}
+}
+
+func TestPromiseFuncVariadic(t *testing.T) {
+	for _, c := range []struct {
+		args []interface{}
+		want string
+	}{
+		{[]interface{}{"ids:"}, "ids:"},
+		{[]interface{}{"ids:", "a"}, "ids:a"},
+		{[]interface{}{"ids:", "a", "b", "c"}, "ids:a,b,c"},
+	} {
+		val, err := callPromiseFunc(t, "Load", c.args...)
+		if err != nil || val.String() != c.want {
+			t.Errorf("Load%v = %v, %v, want %q", c.args, val, err, c.want)
+		}
+	}
+}
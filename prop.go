@@ -0,0 +1,230 @@
+package vue
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// PropType is the JavaScript native constructor name used for prop type
+// checking, it's mapped to the `type` field of the VueJS props object.
+type PropType string
+
+const (
+	PropString   PropType = "String"
+	PropNumber   PropType = "Number"
+	PropBoolean  PropType = "Boolean"
+	PropArray    PropType = "Array"
+	PropObject   PropType = "Object"
+	PropFunction PropType = "Function"
+)
+
+// constructor returns the JavaScript native constructor of the type
+func (t PropType) constructor() *js.Object {
+	return js.Global.Get(string(t))
+}
+
+// ErrPropRequired is reported when a required prop is not passed in
+// by the parent component.
+var ErrPropRequired = errors.New("missing required prop")
+
+// PropError describes a prop validation failure, it's passed to the
+// handler registered by Option.OnPropError.
+type PropError struct {
+	// Prop is the name of the prop that failed validation
+	Prop string
+	// Err is ErrPropRequired, a type check error or the error
+	// returned by Prop.Validator
+	Err error
+}
+
+func (e *PropError) Error() string {
+	return "vue: invalid prop \"" + e.Prop + "\": " + e.Err.Error()
+}
+
+// Prop is the typed declaration of a VueJS prop, it's used to generate
+// the Object-based syntax of the `props` option:
+//
+//	props: {
+//	  name: {
+//	    type: String,
+//	    required: true,
+//	    default: 'foo',
+//	    validator: function (value) { ... }
+//	  }
+//	}
+type Prop struct {
+	// Name of the prop used in the template
+	Name string
+	// Types are the allowed types of the prop, any type is accepted if empty
+	Types []PropType
+	// Required reports a violation when the prop is not passed in
+	Required bool
+	// Default is the default value of the prop, it should be a primitive
+	// value, use DefaultFunc for Object and Array props.
+	Default interface{}
+	// DefaultFunc is the factory of the default value, it's called for
+	// every instance so the returned value would never be shared.
+	DefaultFunc func(vm *ViewModel) interface{}
+	// Validator is the custom validation function,
+	// returning non nil error means the value is invalid.
+	Validator func(val *js.Object) error
+}
+
+// NewProp creates a Prop declaration named `name` accepting `types`
+func NewProp(name string, types ...PropType) *Prop {
+	return &Prop{
+		Name:  name,
+		Types: types,
+	}
+}
+
+// toJS generates the Object-based prop options for VueJS
+func (p *Prop) toJS() js.M {
+	m := js.M{}
+	switch len(p.Types) {
+	case 0:
+	case 1:
+		m["type"] = p.Types[0].constructor()
+	default:
+		types := make([]*js.Object, len(p.Types))
+		for i, t := range p.Types {
+			types[i] = t.constructor()
+		}
+		m["type"] = types
+	}
+	if p.Required {
+		m["required"] = true
+	}
+	if p.DefaultFunc != nil {
+		m["default"] = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			return p.DefaultFunc(newViewModel(this))
+		})
+	} else if p.Default != nil {
+		m["default"] = p.Default
+	}
+	if p.Validator != nil {
+		m["validator"] = func(val *js.Object) bool {
+			return p.Validator(val) == nil
+		}
+	}
+	return m
+}
+
+// validate checks `val` against the declaration, `absent` means the prop
+// was not passed in by the parent component.
+//
+// The checks are done on the Go side because the minified VueJS build
+// strips all prop assertions.
+func (p *Prop) validate(val *js.Object, absent bool) error {
+	if p.Required && absent {
+		return &PropError{Prop: p.Name, Err: ErrPropRequired}
+	}
+	if val == nil || val == js.Undefined {
+		return nil
+	}
+	if len(p.Types) > 0 {
+		got := jsTypeName(val)
+		valid := false
+		expected := make([]string, len(p.Types))
+		for i, t := range p.Types {
+			expected[i] = string(t)
+			if string(t) == got {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return &PropError{
+				Prop: p.Name,
+				Err: errors.New("type check failed, expected " +
+					strings.Join(expected, ", ") + ", got " + got),
+			}
+		}
+	}
+	if p.Validator != nil {
+		if err := p.Validator(val); err != nil {
+			return &PropError{Prop: p.Name, Err: err}
+		}
+	}
+	return nil
+}
+
+// jsTypeName returns the native type name of val like `String` or `Array`
+func jsTypeName(val *js.Object) string {
+	s := js.Global.Get("Object").Get("prototype").Get("toString").Call("call", val).String()
+	// [object Type]
+	return s[len("[object ") : len(s)-1]
+}
+
+// camelize converts a hyphenated name like `max-count` into `maxCount`
+// the same way VueJS does for prop names
+func camelize(name string) string {
+	b := make([]byte, 0, len(name))
+	for i := 0; i < len(name); i++ {
+		if name[i] == '-' && i+1 < len(name) && isWordChar(name[i+1]) {
+			i++
+			b = append(b, strings.ToUpper(name[i:i+1])...)
+			continue
+		}
+		b = append(b, name[i])
+	}
+	return string(b)
+}
+
+// isWordChar reports whether c matches `\w` of JavaScript regexps
+func isWordChar(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// reportPropError calls the error hook set by Option.OnPropError
+// or logs to the console if none was set
+func (c *Option) reportPropError(vm *ViewModel, err error) {
+	if c.propErrorHandler != nil {
+		c.propErrorHandler(vm, err)
+		return
+	}
+	js.Global.Get("console").Call("warn", err.Error())
+}
+
+// AddTypedProp add props with type checking, required flag, default value
+// and custom validator to the genereated VueJS instance (optional).
+//
+// Props are validated when the instance is created and each time the
+// parent passes in a new value, violations are reported to
+// the handler set by Option.OnPropError.
+func (c *Option) AddTypedProp(props ...*Prop) *Option {
+	for _, p := range props {
+		p := p
+		// VueJS keys props by the camelized names
+		key := camelize(p.Name)
+		c.typedProps = append(c.typedProps, p)
+		c.OnLifeCycleEvent(EvtCreated, func(vm *ViewModel) {
+			propsData := vm.Options.Get("propsData")
+			absent := propsData == js.Undefined ||
+				!propsData.Call("hasOwnProperty", key).Bool()
+			if err := p.validate(vm.Get(key), absent); err != nil {
+				c.reportPropError(vm, err)
+			}
+		})
+		c.addMixin("watch", js.M{
+			key: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+				if err := p.validate(arguments[0], false); err != nil {
+					c.reportPropError(newViewModel(this), err)
+				}
+				return nil
+			}),
+		})
+	}
+	return c
+}
+
+// OnPropError sets the handler of prop validation errors, the err passed
+// in is always of type *PropError. Errors are logged to the console
+// if no handler was set.
+func (c *Option) OnPropError(fn func(vm *ViewModel, err error)) *Option {
+	c.propErrorHandler = fn
+	return c
+}
@@ -0,0 +1,52 @@
+//+build js
+
+package vue
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func TestCamelize(t *testing.T) {
+	for _, c := range []struct {
+		name, want string
+	}{
+		{"count", "count"},
+		{"max-count", "maxCount"},
+		{"a-b-c", "aBC"},
+		{"a--b", "a-B"},
+		{"item-2", "item2"},
+		{"last-", "last-"},
+	} {
+		if got := camelize(c.name); got != c.want {
+			t.Errorf("camelize(%q) = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestAddTypedPropHyphenated(t *testing.T) {
+	var errs []error
+	opt := NewOption()
+	opt.AddTypedProp(&Prop{
+		Name:     "max-count",
+		Types:    []PropType{PropNumber},
+		Required: true,
+	})
+	opt.OnPropError(func(vm *ViewModel, err error) {
+		errs = append(errs, err)
+	})
+	c := opt.NewComponent()
+	vm := newViewModel(c.Object.New(js.M{
+		"propsData": js.M{"maxCount": 3},
+	}))
+	if len(errs) != 0 {
+		t.Fatalf("passed prop reported: %v", errs)
+	}
+	// the watcher validates the new value
+	vm.Object.Set("maxCount", "many")
+	<-vm.NextTickChan()
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors after setting an invalid value, want 1", len(errs))
+	}
+}
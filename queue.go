@@ -0,0 +1,75 @@
+package vue
+
+import (
+	"sync"
+)
+
+// queue is an unbounded FIFO delivering items pushed from JavaScript
+// callbacks to Go channels, pushing never blocks since JavaScript callbacks
+// must return immediately.
+type queue struct {
+	mu    sync.Mutex
+	items []interface{}
+	// coalesce merges the pending item with the new one if not nil
+	coalesce func(pending, item interface{}) interface{}
+	notify   chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// newQueue starts a goroutine calling `deliver` for every item pushed,
+// items waiting for delivery are merged into one by `coalesce` if not nil,
+// `deliver` should return false when `done` is closed before the item is
+// delivered. `finish` is called when the queue is closed.
+func newQueue(
+	coalesce func(pending, item interface{}) interface{},
+	deliver func(item interface{}, done <-chan struct{}) bool,
+	finish func(),
+) *queue {
+	q := &queue{
+		coalesce: coalesce,
+		notify:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer finish()
+		for {
+			select {
+			case <-q.notify:
+			case <-q.done:
+				return
+			}
+			q.mu.Lock()
+			items := q.items
+			q.items = nil
+			q.mu.Unlock()
+			for _, item := range items {
+				if !deliver(item, q.done) {
+					return
+				}
+			}
+		}
+	}()
+	return q
+}
+
+func (q *queue) push(item interface{}) {
+	q.mu.Lock()
+	if q.coalesce != nil && len(q.items) > 0 {
+		q.items[0] = q.coalesce(q.items[0], item)
+	} else {
+		q.items = append(q.items, item)
+	}
+	q.mu.Unlock()
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// close stops the delivery, pending items are dropped
+func (q *queue) close() {
+	q.once.Do(func() {
+		close(q.done)
+	})
+}
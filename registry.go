@@ -0,0 +1,96 @@
+package vue
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	// vMap maps the bound struct pointers to VueJS instances
+	vMap = make(map[interface{}]*ViewModel, 0)
+	// sMap maps the `_uid` of VueJS instances to the bound struct pointers
+	sMap = make(map[int]interface{}, 0)
+)
+
+// ErrVMNotFound is returned by LookupVM when the struct pointer is not
+// bound to any VueJS instance or the instance was destroyed.
+var ErrVMNotFound = errors.New("vue: no ViewModel bound to the struct pointer")
+
+// bindStruct connects structPtr with the VueJS instance vm, it's called in
+// the `beforeCreate` hook. The connection is kept until all `destroyed`
+// hooks have run, so GetVM works in Destroyed hook methods too.
+func bindStruct(structPtr interface{}, vm *ViewModel) {
+	vMap[structPtr] = vm
+	sMap[vm.uid()] = structPtr
+	vm.onDestroyed(func() {
+		unbindStruct(vm)
+	})
+}
+
+// unbindStruct removes vm and its struct pointer from the registry
+func unbindStruct(vm *ViewModel) {
+	uid := vm.uid()
+	if structPtr, ok := sMap[uid]; ok {
+		delete(vMap, structPtr)
+		delete(sMap, uid)
+	}
+}
+
+// lookupStruct returns the gopherjs struct pointer bound to vm or nil
+func lookupStruct(vm *ViewModel) interface{} {
+	return sMap[vm.uid()]
+}
+
+// uid returns the unique id VueJS assigned to the instance
+func (v *ViewModel) uid() int {
+	return v.Get("_uid").Int()
+}
+
+// LookupVM returns coresponding VueJS instance from a gopherjs struct pointer
+// like GetVM, but returns ErrVMNotFound instead of panicking when there's
+// no such instance.
+func LookupVM(structPtr interface{}) (*ViewModel, error) {
+	vm, ok := vMap[structPtr]
+	if !ok {
+		return nil, ErrVMNotFound
+	}
+	return vm, nil
+}
+
+// LiveVM describes a VueJS instance bound to a gopherjs struct pointer
+type LiveVM struct {
+	// UID is the unique id VueJS assigned to the instance
+	UID int
+	// Name is the component name or empty for anonymous instances
+	Name string
+	// Struct is the bound gopherjs struct pointer
+	Struct interface{}
+	// VM is the VueJS instance
+	VM *ViewModel
+}
+
+// LiveViewModels lists all VueJS instances bound to struct pointers by
+// New or NewComponent which are not destroyed yet, ordered by creation.
+// It's meant for debugging purpose like auditing leaked instances.
+func LiveViewModels() []LiveVM {
+	list := make([]LiveVM, 0, len(sMap))
+	for uid, structPtr := range sMap {
+		vm := vMap[structPtr]
+		name := ""
+		if n := vm.Options.Get("name"); n != js.Undefined && n != nil {
+			name = n.String()
+		}
+		list = append(list, LiveVM{
+			UID:    uid,
+			Name:   name,
+			Struct: structPtr,
+			VM:     vm,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].UID < list[j].UID
+	})
+	return list
+}
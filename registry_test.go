@@ -0,0 +1,49 @@
+//+build js
+
+package vue
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type destroyedModel struct {
+	*js.Object
+	Name string `js:"name"`
+	// vm is got by GetVM in the Destroyed hook
+	vm *ViewModel
+}
+
+func (m *destroyedModel) Destroyed(vm *ViewModel) {
+	m.vm = GetVM(m)
+}
+
+func checkDestroyed(t *testing.T, m *destroyedModel, vm *ViewModel) {
+	vm.Call("$destroy")
+	if m.vm == nil {
+		t.Fatal("Destroyed hook is not called")
+	}
+	if m.vm.Object != vm.Object {
+		t.Error("GetVM in the Destroyed hook returns another instance")
+	}
+	if _, err := LookupVM(m); err != ErrVMNotFound {
+		t.Errorf("LookupVM after destroyed returns %v, want ErrVMNotFound", err)
+	}
+}
+
+func TestDestroyedHookComponent(t *testing.T) {
+	var last *destroyedModel
+	c := NewComponent(func() interface{} {
+		last = &destroyedModel{Object: js.Global.Get("Object").New()}
+		return last
+	}, "<div>{{ name }}</div>")
+	vm := c.New()
+	checkDestroyed(t, last, vm)
+}
+
+func TestDestroyedHookNew(t *testing.T) {
+	m := &destroyedModel{Object: js.Global.Get("Object").New()}
+	vm := New(nil, m)
+	checkDestroyed(t, m, vm)
+}
@@ -0,0 +1,186 @@
+package vue
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// VNode is the virtual node created in render functions
+type VNode struct {
+	*js.Object
+}
+
+// VNodeDirective is a directive applied to a vnode, like `v-my-directive`
+type VNodeDirective struct {
+	// Name of the directive without the `v-` prefix
+	Name string
+	// Value passed to the directive
+	Value interface{}
+	// Expression is the string form of the value, optional
+	Expression string
+	// Arg is the argument like `foo` in `v-my-directive:foo`, optional
+	Arg string
+	// Modifiers like `{bar: true}` in `v-my-directive.bar`, optional
+	Modifiers map[string]bool
+}
+
+// VNodeData is the data object of a vnode, all fields are optional:
+//
+//	{
+//	  class: { foo: true, bar: false },
+//	  style: { color: 'red', fontSize: '14px' },
+//	  attrs: { id: 'foo' },
+//	  props: { myProp: 'bar' },
+//	  domProps: { innerHTML: 'baz' },
+//	  on: { click: this.clickHandler },
+//	  nativeOn: { click: this.nativeClickHandler },
+//	  directives: [ ... ],
+//	  slot: 'name-of-slot',
+//	  scopedSlots: { default: props => h('span', props.text) },
+//	  key: 'myKey',
+//	  ref: 'myRef'
+//	}
+type VNodeData struct {
+	// Class is a string, a []string or a map[string]bool like `v-bind:class`
+	Class interface{}
+	// Style is a string or a map[string]string like `v-bind:style`
+	Style interface{}
+	// Attrs are normal HTML attributes
+	Attrs js.M
+	// Props are component props
+	Props js.M
+	// DomProps are DOM properties like `innerHTML`
+	DomProps js.M
+	// On are event handlers, the modifiers like `.prevent` are not supported
+	On js.M
+	// NativeOn are native event handlers for components only
+	NativeOn js.M
+	// Directives applied to the vnode
+	Directives []*VNodeDirective
+	// Key of the vnode
+	Key interface{}
+	// Ref name of the vnode, the element or component would be accessible
+	// in `vm.$refs`
+	Ref string
+	// Slot name if the vnode is a child of some component
+	Slot string
+	// ScopedSlots passed to a component, the values are Go funcs of the
+	// form `func(props T) R` where T can be any type supported by Decode
+	// and R is one of *VNode, []*VNode or string
+	ScopedSlots map[string]interface{}
+}
+
+// toJS creates a fresh JavaScript data object as required by VueJS
+func (d *VNodeData) toJS() *js.Object {
+	if d == nil {
+		return js.Undefined
+	}
+	obj := js.Global.Get("Object").New()
+	if d.Class != nil {
+		obj.Set("class", d.Class)
+	}
+	if d.Style != nil {
+		obj.Set("style", d.Style)
+	}
+	for name, m := range map[string]js.M{
+		"attrs":    d.Attrs,
+		"props":    d.Props,
+		"domProps": d.DomProps,
+		"on":       d.On,
+		"nativeOn": d.NativeOn,
+	} {
+		if len(m) > 0 {
+			obj.Set(name, m)
+		}
+	}
+	if len(d.Directives) > 0 {
+		dirs := make([]js.M, len(d.Directives))
+		for i, dir := range d.Directives {
+			dirs[i] = js.M{
+				"name":       dir.Name,
+				"value":      dir.Value,
+				"expression": dir.Expression,
+				"arg":        dir.Arg,
+				"modifiers":  dir.Modifiers,
+			}
+		}
+		obj.Set("directives", dirs)
+	}
+	if d.Key != nil {
+		obj.Set("key", d.Key)
+	}
+	if d.Ref != "" {
+		obj.Set("ref", d.Ref)
+	}
+	if d.Slot != "" {
+		obj.Set("slot", d.Slot)
+	}
+	if len(d.ScopedSlots) > 0 {
+		slots := js.M{}
+		for name, fn := range d.ScopedSlots {
+			slots[name] = makeScopedSlot(fn)
+		}
+		obj.Set("scopedSlots", slots)
+	}
+	return obj
+}
+
+// H is the typed `h` (createElement) helper of render functions:
+//
+//	h("div", &vue.VNodeData{Class: "todo"},
+//		h("span", nil, "text"),
+//		h(myComponent, &vue.VNodeData{Props: js.M{"item": item}}),
+//	)
+//
+// `tag` is a HTML tag name, a registered component name or a *Component.
+// `children` can be *VNode, []*VNode, strings for text nodes
+// or *js.Object of vnodes.
+type H func(tag interface{}, data *VNodeData, children ...interface{}) *VNode
+
+// RenderFunc is the typed VueJS render function
+type RenderFunc func(vm *ViewModel, h H) *VNode
+
+func newH(createElement *js.Object) H {
+	return func(tag interface{}, data *VNodeData, children ...interface{}) *VNode {
+		if c, ok := tag.(*Component); ok {
+			tag = c.Object
+		}
+		return &VNode{
+			Object: createElement.Invoke(tag, data.toJS(), normalizeChildren(children)),
+		}
+	}
+}
+
+// normalizeChildren flattens children into a JavaScript array
+func normalizeChildren(children []interface{}) *js.Object {
+	arr := js.Global.Get("Array").New()
+	for _, child := range children {
+		switch c := child.(type) {
+		case nil:
+		case *VNode:
+			if c != nil {
+				arr.Call("push", c.Object)
+			}
+		case []*VNode:
+			for _, n := range c {
+				arr.Call("push", n.Object)
+			}
+		default:
+			arr.Call("push", c)
+		}
+	}
+	return arr
+}
+
+// SetRenderFunc set the typed render function of the VueJS instance or
+// component which takes priority over the template, thus Go components can
+// skip string templates entirely.
+func (o *Option) SetRenderFunc(r RenderFunc) *Option {
+	o.Set("render", js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		vnode := r(newViewModel(this), newH(arguments[0]))
+		if vnode == nil {
+			return nil
+		}
+		return vnode.Object
+	}))
+	return o
+}
@@ -0,0 +1,138 @@
+// hash.js checks hash mode navigation of a vue-router build against the
+// VueJS build embeded by gopherjs-vue, run it from the repository root:
+//
+//   node router/check/hash.js path/to/vue-router.js
+//
+// Only location, history and the hashchange event are used by the hash
+// mode, so a minimal window is faked instead of requiring a browser.
+'use strict';
+
+const path = require('path');
+
+const routerPath = process.argv[2];
+if (!routerPath) {
+  console.error('usage: node router/check/hash.js path/to/vue-router.js');
+  process.exit(2);
+}
+
+const Vue = require(path.join(__dirname, '../../jscode/minified/vue-2.1.10.min.inc.js'));
+
+const base = 'http://localhost/index.html';
+const listeners = [];
+const location = {
+  href: base,
+  get hash() {
+    const i = this.href.indexOf('#');
+    return i < 0 ? '' : this.href.slice(i);
+  },
+  set hash(h) {
+    this.href = this.href.replace(/#.*$/, '') + '#' + String(h).replace(/^#/, '');
+  },
+  replace(url) {
+    this.href = url;
+  },
+};
+// window is defined after loading VueJS, so it keeps running as in NodeJS
+global.window = {
+  location,
+  navigator: { userAgent: 'node' },
+  history: {},
+  addEventListener(type, fn) {
+    if (type === 'hashchange') {
+      listeners.push(fn);
+    }
+  },
+  removeEventListener() {},
+  scrollTo() {},
+};
+
+const VueRouter = require(path.resolve(routerPath));
+Vue.use(VueRouter);
+
+// visits the hash like the browser back button does
+function visit(hash) {
+  location.hash = hash;
+  listeners.forEach(fn => fn());
+}
+
+const tick = () => new Promise(resolve => setTimeout(resolve, 0));
+
+let failed = 0;
+function expect(what, got, want) {
+  if (got !== want) {
+    failed++;
+    console.error('FAIL ' + what + ': got ' + JSON.stringify(got) + ', want ' + JSON.stringify(want));
+  }
+}
+
+const view = name => Vue.extend({ render: h => h('div', name) });
+
+const log = [];
+const router = new VueRouter({
+  mode: 'hash',
+  routes: [
+    { path: '/', name: 'home', component: view('home') },
+    {
+      path: '/user/:id',
+      component: view('user'),
+      children: [{ path: 'profile', component: view('profile'), meta: { auth: true } }],
+    },
+    { path: '/admin', component: view('admin'), beforeEnter: (to, from, next) => next('/') },
+    { path: '/blocked', component: view('blocked'), beforeEnter: (to, from, next) => next(false) },
+    { path: '/old', redirect: '/user/old' },
+  ],
+});
+router.beforeEach((to, from, next) => {
+  log.push('before ' + to.fullPath);
+  next();
+});
+router.afterEach(to => log.push('after ' + to.fullPath));
+
+const app = new Vue({
+  router,
+  computed: {
+    path() {
+      return this.$route.path;
+    },
+  },
+});
+
+(async () => {
+  await tick();
+  expect('initial path', app.$route.path, '/');
+  expect('initial hash', location.hash, '#/');
+
+  router.push('/user/foo/profile');
+  await tick();
+  expect('nested path', app.path, '/user/foo/profile');
+  expect('param', app.$route.params.id, 'foo');
+  expect('matched', app.$route.matched.length, 2);
+  expect('meta', app.$route.meta.auth, true);
+  expect('pushed hash', location.hash, '#/user/foo/profile');
+  expect('$router', app.$router, router);
+
+  router.push('/admin');
+  await tick();
+  expect('beforeEnter redirect', app.$route.path, '/');
+
+  router.push('/blocked');
+  await tick();
+  expect('aborted navigation', app.$route.path, '/');
+
+  router.replace('/old');
+  await tick();
+  expect('redirect', app.$route.path, '/user/old');
+  expect('replaced hash', location.hash, '#/user/old');
+
+  visit('/user/bar');
+  await tick();
+  expect('hashchange', app.$route.params.id, 'bar');
+
+  expect('afterEach', log.indexOf('after /user/foo/profile') >= 0, true);
+  expect('beforeEach', log.indexOf('before /admin') >= 0, true);
+
+  if (failed > 0) {
+    process.exit(1);
+  }
+  console.log('ok: hash mode navigation works with VueJS ' + Vue.version);
+})();
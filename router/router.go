@@ -0,0 +1,297 @@
+// Package router provides gopherjs bindings for vue-router, routes are
+// declared as Go route tables and navigation guards are Go funcs.
+//
+// The vue-router JavaScript code is not embeded, it should be loaded
+// before the gopherjs code so the global `VueRouter` is available:
+//
+//	<script src="vue-router.js"></script>
+//	<script src="main.js"></script>
+//
+// Use vue-router 2.1.x, the release line matching the embeded VueJS 2.1.10.
+// Hash mode navigation of a vue-router build can be checked against the
+// embeded VueJS build by NodeJS:
+//
+//	node router/check/hash.js path/to/vue-router.js
+package router
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/oskca/gopherjs-vue"
+)
+
+// Mode is the history mode of the Router
+type Mode string
+
+const (
+	// ModeHash uses the URL hash for routing, works in all browsers
+	ModeHash Mode = "hash"
+	// ModeHistory uses the HTML5 History API and needs server config
+	ModeHistory Mode = "history"
+	// ModeAbstract works in all JavaScript environments by keeping
+	// the history in memory
+	ModeAbstract Mode = "abstract"
+)
+
+// Route is the route object, `vm.$route` in VueJS world
+type Route struct {
+	*js.Object
+	// Path of the current route, always resolved as an absolute path
+	Path string `js:"path"`
+	// Name of the current route, if it has one
+	Name string `js:"name"`
+	// Hash of the current route (with the #), if it has one
+	Hash string `js:"hash"`
+	// FullPath is the full resolved URL including query and hash
+	FullPath string `js:"fullPath"`
+	// Params contains dynamic segments and star segments
+	Params *js.Object `js:"params"`
+	// Query contains key/value pairs of the query string
+	Query *js.Object `js:"query"`
+	// Meta is the merged meta fields of the matched routes
+	Meta *js.Object `js:"meta"`
+}
+
+func newRoute(o *js.Object) *Route {
+	if o == nil || o == js.Undefined {
+		return nil
+	}
+	return &Route{Object: o}
+}
+
+// Param returns the dynamic segment `name`, e.g. `id` of `/user/:id`
+func (r *Route) Param(name string) string {
+	return stringOf(r.Params.Get(name))
+}
+
+// QueryValue returns the query string value of `name`
+func (r *Route) QueryValue(name string) string {
+	return stringOf(r.Query.Get(name))
+}
+
+// Matched returns the route records of all nested path segments
+func (r *Route) Matched() []*js.Object {
+	matched := r.Get("matched")
+	list := make([]*js.Object, matched.Length())
+	for i := range list {
+		list[i] = matched.Index(i)
+	}
+	return list
+}
+
+func stringOf(o *js.Object) string {
+	if o == nil || o == js.Undefined {
+		return ""
+	}
+	return o.String()
+}
+
+// Next resolves a navigation guard, exactly one of its methods must be
+// called, it's ok to call it later from a goroutine.
+type Next struct {
+	fn *js.Object
+}
+
+// Continue moves on to the next guard
+func (n *Next) Continue() {
+	n.fn.Invoke()
+}
+
+// Abort aborts the current navigation
+func (n *Next) Abort() {
+	n.fn.Invoke(false)
+}
+
+// Redirect aborts the current navigation and starts a new one to `path`
+func (n *Next) Redirect(path string) {
+	n.fn.Invoke(path)
+}
+
+// Guard is a navigation guard
+type Guard func(to, from *Route, next *Next)
+
+// AfterHook is called after the navigation is confirmed
+type AfterHook func(to, from *Route)
+
+func (g Guard) toJS() *js.Object {
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		g(newRoute(arguments[0]), newRoute(arguments[1]), &Next{fn: arguments[2]})
+		return nil
+	})
+}
+
+// RouteConfig is a record of the route table
+type RouteConfig struct {
+	// Path of the route, like `/user/:id`
+	Path string
+	// Name of the route, optional
+	Name string
+	// Component rendered in the default `<router-view>`
+	Component *vue.Component
+	// Components rendered in the named `<router-view name="...">`
+	Components map[string]*vue.Component
+	// Redirect to another path, optional
+	Redirect string
+	// Alias of the path, optional
+	Alias string
+	// Children are the nested routes rendered in
+	// the `<router-view>` of Component
+	Children []*RouteConfig
+	// Meta fields of the route, optional
+	Meta js.M
+	// BeforeEnter is the per route guard, optional
+	BeforeEnter Guard
+}
+
+func (r *RouteConfig) toJS() js.M {
+	m := js.M{
+		"path": r.Path,
+	}
+	if r.Name != "" {
+		m["name"] = r.Name
+	}
+	if r.Component != nil {
+		m["component"] = r.Component.Object
+	}
+	if len(r.Components) > 0 {
+		coms := js.M{}
+		for name, c := range r.Components {
+			coms[name] = c.Object
+		}
+		m["components"] = coms
+	}
+	if r.Redirect != "" {
+		m["redirect"] = r.Redirect
+	}
+	if r.Alias != "" {
+		m["alias"] = r.Alias
+	}
+	if len(r.Children) > 0 {
+		m["children"] = routesToJS(r.Children)
+	}
+	if r.Meta != nil {
+		m["meta"] = r.Meta
+	}
+	if r.BeforeEnter != nil {
+		m["beforeEnter"] = r.BeforeEnter.toJS()
+	}
+	return m
+}
+
+func routesToJS(routes []*RouteConfig) []js.M {
+	list := make([]js.M, len(routes))
+	for i, r := range routes {
+		list[i] = r.toJS()
+	}
+	return list
+}
+
+// Options is used to create the Router
+type Options struct {
+	// Mode defaults to ModeHash
+	Mode Mode
+	// Base URL of the app, only used in ModeHistory
+	Base string
+	// Routes is the route table
+	Routes []*RouteConfig
+	// LinkActiveClass is the class of active `<router-link>`,
+	// defaults to `router-link-active`
+	LinkActiveClass string
+}
+
+// Router is the vue-router instance, `vm.$router` in VueJS world
+type Router struct {
+	*js.Object
+	// Mode is the actual history mode being used
+	Mode Mode `js:"mode"`
+}
+
+// New creates the Router, use Router.Apply to make it available
+// for the root VueJS instance and all its children.
+func New(opts *Options) *Router {
+	VueRouter := js.Global.Get("VueRouter")
+	if VueRouter == js.Undefined {
+		panic("router: vue-router is not loaded")
+	}
+	vue.Use(VueRouter)
+	mode := opts.Mode
+	if mode == "" {
+		mode = ModeHash
+	}
+	conf := js.M{
+		"mode":   string(mode),
+		"routes": routesToJS(opts.Routes),
+	}
+	if opts.Base != "" {
+		conf["base"] = opts.Base
+	}
+	if opts.LinkActiveClass != "" {
+		conf["linkActiveClass"] = opts.LinkActiveClass
+	}
+	return &Router{
+		Object: VueRouter.New(conf),
+	}
+}
+
+// Apply sets the `router` option of the root VueJS instance
+func (r *Router) Apply(o *vue.Option) *vue.Option {
+	o.Set("router", r.Object)
+	return o
+}
+
+// CurrentRoute returns the current route
+func (r *Router) CurrentRoute() *Route {
+	return newRoute(r.Get("currentRoute"))
+}
+
+// BeforeEach registers a global guard called before every navigation
+func (r *Router) BeforeEach(g Guard) *Router {
+	r.Call("beforeEach", g.toJS())
+	return r
+}
+
+// AfterEach registers a global hook called after every navigation
+func (r *Router) AfterEach(fn AfterHook) *Router {
+	r.Call("afterEach", func(to, from *js.Object) {
+		fn(newRoute(to), newRoute(from))
+	})
+	return r
+}
+
+// Push navigates to `path` adding a new history entry
+func (r *Router) Push(path string) {
+	r.Call("push", path)
+}
+
+// Replace navigates to `path` replacing the current history entry
+func (r *Router) Replace(path string) {
+	r.Call("replace", path)
+}
+
+// Go moves `n` steps in the history, negative `n` means going backward
+func (r *Router) Go(n int) {
+	r.Call("go", n)
+}
+
+// Back is the same as Go(-1)
+func (r *Router) Back() {
+	r.Go(-1)
+}
+
+// Forward is the same as Go(1)
+func (r *Router) Forward() {
+	r.Go(1)
+}
+
+// GetRoute returns `vm.$route` of the VueJS instance
+func GetRoute(vm *vue.ViewModel) *Route {
+	return newRoute(vm.Get("$route"))
+}
+
+// GetRouter returns `vm.$router` of the VueJS instance
+func GetRouter(vm *vue.ViewModel) *Router {
+	r := vm.Get("$router")
+	if r == nil || r == js.Undefined {
+		return nil
+	}
+	return &Router{Object: r}
+}
@@ -0,0 +1,197 @@
+package vue
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ReactiveSlice is an element typed view of an array field of a VueJS
+// instance, all modifications go through the array methods observed by
+// VueJS, thus views are updated without replacing the whole array as
+// `append` on `js struct tag` fields does:
+//
+//	todos := vue.NewReactiveSlice(vm, "todos", []*Todo(nil))
+//	todos.Append(NewTodo("buy milk"))
+//	todos.SortFunc(func(a, b *Todo) bool {
+//		return a.Time < b.Time
+//	})
+//	for _, t := range todos.ToSlice().([]*Todo) {
+//		println(t.Content)
+//	}
+//
+// Elements are converted from JavaScript by Decode and into JavaScript
+// as GopherJS does for any value passed to JavaScript.
+type ReactiveSlice struct {
+	vm    *ViewModel
+	field string
+	typ   reflect.Type // the slice type
+}
+
+// NewReactiveSlice creates a ReactiveSlice of the array `field` of `vm`,
+// `sliceType` is any value of the Go slice type like `[]*Todo(nil)`,
+// the element type is taken from it.
+func NewReactiveSlice(vm *ViewModel, field string, sliceType interface{}) *ReactiveSlice {
+	t := reflect.TypeOf(sliceType)
+	if t == nil || t.Kind() != reflect.Slice {
+		panic("vue: NewReactiveSlice needs a slice type, got " + typeString(t))
+	}
+	return &ReactiveSlice{
+		vm:    vm,
+		field: field,
+		typ:   t,
+	}
+}
+
+func typeString(t reflect.Type) string {
+	if t == nil {
+		return "nil"
+	}
+	return t.String()
+}
+
+// array returns the observed array, it's looked up every time because
+// the field may be replaced as a whole.
+func (s *ReactiveSlice) array() *js.Object {
+	arr := s.vm.Get(s.field)
+	if arr == nil || arr == js.Undefined {
+		panic("vue: field " + s.field + " is not an array")
+	}
+	return arr
+}
+
+// check panics if `items` are not assignable to the element type
+func (s *ReactiveSlice) check(items []interface{}) {
+	elem := s.typ.Elem()
+	for _, item := range items {
+		if !assignable(item, elem) {
+			panic("vue: " + typeString(reflect.TypeOf(item)) + " can not be used as " +
+				elem.String() + " of field " + s.field)
+		}
+	}
+}
+
+// assignable reports whether `item` can be used as a value of type `t`
+func assignable(item interface{}, t reflect.Type) bool {
+	if item == nil {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func:
+			return true
+		}
+		return false
+	}
+	return reflect.TypeOf(item).AssignableTo(t)
+}
+
+func (s *ReactiveSlice) splice(index, howmany int, items ...interface{}) *js.Object {
+	return Splice(s.array(), index, howmany, items...)
+}
+
+// Len returns the length of the array
+func (s *ReactiveSlice) Len() int {
+	return s.array().Length()
+}
+
+// At returns the element at index `i` decoded into the element type
+func (s *ReactiveSlice) At(i int) interface{} {
+	if i < 0 || i >= s.Len() {
+		panic("vue: index out of range of field " + s.field)
+	}
+	return Decode(s.array().Index(i), s.typ.Elem()).Interface()
+}
+
+// Append adds `items` at the end of the array
+func (s *ReactiveSlice) Append(items ...interface{}) {
+	s.check(items)
+	s.array().Call("push", items...)
+}
+
+// Insert adds `items` before index `i`, `i` equals to Len appends
+func (s *ReactiveSlice) Insert(i int, items ...interface{}) {
+	if i < 0 || i > s.Len() {
+		panic("vue: index out of range of field " + s.field)
+	}
+	s.check(items)
+	s.splice(i, 0, items...)
+}
+
+// RemoveAt removes the element at index `i` and returns it
+func (s *ReactiveSlice) RemoveAt(i int) interface{} {
+	if i < 0 || i >= s.Len() {
+		panic("vue: index out of range of field " + s.field)
+	}
+	removed := s.splice(i, 1)
+	return Decode(removed.Index(0), s.typ.Elem()).Interface()
+}
+
+// Filter keeps the elements for which `keep` returns true, the form of
+// `keep` is `func(item T) bool` where T is the element type.
+// The array is updated by a single splice.
+func (s *ReactiveSlice) Filter(keep interface{}) {
+	fn := s.elemFunc(keep, 1)
+	arr := s.array()
+	n := arr.Length()
+	kept := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		item := arr.Index(i)
+		if fn.Call([]reflect.Value{Decode(item, s.typ.Elem())})[0].Bool() {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) < n {
+		Splice(arr, 0, n, kept...)
+	}
+}
+
+// SortFunc sorts the array in place by `less`, whose form is
+// `func(a, b T) bool` where T is the element type.
+func (s *ReactiveSlice) SortFunc(less interface{}) {
+	fn := s.elemFunc(less, 2)
+	elem := s.typ.Elem()
+	Sort(s.array(), func(a, b *js.Object) int {
+		va, vb := Decode(a, elem), Decode(b, elem)
+		switch {
+		case fn.Call([]reflect.Value{va, vb})[0].Bool():
+			return -1
+		case fn.Call([]reflect.Value{vb, va})[0].Bool():
+			return 1
+		}
+		return 0
+	})
+}
+
+// Replace replaces all elements by the Go slice `items`, it must be of
+// the slice type of the ReactiveSlice.
+func (s *ReactiveSlice) Replace(items interface{}) {
+	v := reflect.ValueOf(items)
+	if v.Type() != s.typ {
+		panic("vue: Replace needs " + s.typ.String() + ", got " + v.Type().String())
+	}
+	args := make([]interface{}, v.Len())
+	for i := range args {
+		args[i] = v.Index(i).Interface()
+	}
+	s.splice(0, s.Len(), args...)
+}
+
+// ToSlice converts the array into a Go slice of the slice type,
+// elements of gopherjs structs are still backed by the array elements.
+func (s *ReactiveSlice) ToSlice() interface{} {
+	return Decode(s.array(), s.typ).Interface()
+}
+
+// elemFunc checks that `fn` is `func(T, ...) bool` with `n` arguments of
+// the element type T
+func (s *ReactiveSlice) elemFunc(fn interface{}, n int) reflect.Value {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	ok := t.Kind() == reflect.Func && t.NumIn() == n && t.NumOut() == 1 &&
+		t.Out(0).Kind() == reflect.Bool
+	for i := 0; ok && i < n; i++ {
+		ok = t.In(i) == s.typ.Elem()
+	}
+	if !ok {
+		panic("vue: invalid func " + t.String() + " for elements of " + s.typ.String())
+	}
+	return v
+}
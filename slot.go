@@ -0,0 +1,77 @@
+package vue
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ScopedSlot renders a scoped slot with `props`, it's returned by
+// ViewModel.ScopedSlot for calling in render functions.
+type ScopedSlot func(props interface{}) []*VNode
+
+// vnodesOf converts a vnode, an array of vnodes or undefined into []*VNode
+func vnodesOf(o *js.Object) []*VNode {
+	if o == nil || o == js.Undefined {
+		return nil
+	}
+	if !js.Global.Get("Array").Call("isArray", o).Bool() {
+		return []*VNode{{Object: o}}
+	}
+	list := make([]*VNode, o.Length())
+	for i := range list {
+		list[i] = &VNode{Object: o.Index(i)}
+	}
+	return list
+}
+
+// Slot returns the vnodes distributed to slot `name`,
+// use "default" for the default slot.
+func (v *ViewModel) Slot(name string) []*VNode {
+	return vnodesOf(v.Slots.Get(name))
+}
+
+// HasSlot reports whether there's content or a scoped slot for slot `name`
+func (v *ViewModel) HasSlot(name string) bool {
+	return v.Slots.Get(name) != js.Undefined ||
+		v.ScopedSlots.Get(name) != js.Undefined
+}
+
+// SlotNames returns the names of all non scoped slots with content
+func (v *ViewModel) SlotNames() []string {
+	return js.Keys(v.Slots)
+}
+
+// ScopedSlot returns the scoped slot `name` passed in by the parent or nil
+func (v *ViewModel) ScopedSlot(name string) ScopedSlot {
+	fn := v.ScopedSlots.Get(name)
+	if fn == js.Undefined {
+		return nil
+	}
+	return func(props interface{}) []*VNode {
+		return vnodesOf(fn.Invoke(props))
+	}
+}
+
+// makeScopedSlot converts the Go func `fn` into a VueJS scoped slot function,
+// the form of `fn` is:
+//
+//	func(props T) R
+//
+// where T can be any type supported by Decode
+// and R is one of *VNode, []*VNode or string.
+func makeScopedSlot(fn interface{}) *js.Object {
+	f := reflect.ValueOf(fn)
+	t := f.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 1 {
+		panic("vue: invalid scoped slot func " + t.String())
+	}
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		var props *js.Object
+		if len(arguments) > 0 {
+			props = arguments[0]
+		}
+		out := f.Call([]reflect.Value{Decode(props, t.In(0))})
+		return normalizeChildren([]interface{}{out[0].Interface()})
+	})
+}
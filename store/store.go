@@ -0,0 +1,241 @@
+// Package store provides a centralized state store for gopherjs-vue
+// components in the way of Vuex.
+//
+// The state is a gopherjs struct pointer with an embeded `*js.Object` which
+// is made reactive by VueJS, it should only be changed by committing
+// mutations. Actions run in goroutines and may block, they commit
+// mutations when the async work is done.
+//
+//	type State struct {
+//		*js.Object
+//		Count int `js:"count"`
+//	}
+//
+//	st := &State{Object: js.Global.Get("Object").New()}
+//	st.Count = 0
+//	s := store.New(st)
+//	s.Mutation("inc", func(state, payload interface{}) {
+//		state.(*State).Count += payload.(int)
+//	})
+//	s.Getter("double", func(state interface{}) interface{} {
+//		return state.(*State).Count * 2
+//	})
+//	// in component definitions
+//	s.MapState(opt, "count")
+//	s.MapGetters(opt, "double")
+//	// <button @click="inc(1)">
+//	s.MapMutations(opt, "inc")
+package store
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/oskca/gopherjs-vue"
+)
+
+// Mutation changes the state synchronously
+type Mutation func(state interface{}, payload interface{})
+
+// Action does async work in a goroutine and commits mutations through ctx
+type Action func(ctx *Context, payload interface{}) error
+
+// Getter computes derived state, it's cached as a computed property
+// by every Option it's mapped to
+type Getter func(state interface{}) interface{}
+
+// Subscriber is called after every mutation
+type Subscriber func(mutation string, payload interface{}, state interface{})
+
+// Context is passed to actions
+type Context struct {
+	store *Store
+}
+
+// State returns the state of the store
+func (c *Context) State() interface{} {
+	return c.store.state
+}
+
+// Commit commits mutation `name` with the optional payload
+func (c *Context) Commit(name string, payload ...interface{}) {
+	c.store.Commit(name, payload...)
+}
+
+// Dispatch dispatches action `name` with the optional payload
+func (c *Context) Dispatch(name string, payload ...interface{}) <-chan error {
+	return c.store.Dispatch(name, payload...)
+}
+
+// Store is the centralized state container
+type Store struct {
+	state       interface{}
+	vm          *vue.ViewModel
+	mutations   map[string]Mutation
+	actions     map[string]Action
+	getters     map[string]Getter
+	subscribers []Subscriber
+}
+
+// New creates the Store with `state` which should be a gopherjs struct
+// pointer with an embeded `*js.Object` and proper `js struct tag` fields.
+func New(state interface{}) *Store {
+	// a hidden VueJS instance makes the state reactive
+	opt := vue.NewOption()
+	opt.Data = js.M{
+		"$$state": state,
+	}
+	return &Store{
+		state:     state,
+		vm:        opt.NewViewModel(),
+		mutations: make(map[string]Mutation, 0),
+		actions:   make(map[string]Action, 0),
+		getters:   make(map[string]Getter, 0),
+	}
+}
+
+// State returns the state of the store, it should be changed
+// by mutations only.
+func (s *Store) State() interface{} {
+	return s.state
+}
+
+// Mutation registers mutation `name`
+func (s *Store) Mutation(name string, fn Mutation) *Store {
+	s.mutations[name] = fn
+	return s
+}
+
+// Action registers action `name`
+func (s *Store) Action(name string, fn Action) *Store {
+	s.actions[name] = fn
+	return s
+}
+
+// Getter registers getter `name`
+func (s *Store) Getter(name string, fn Getter) *Store {
+	s.getters[name] = fn
+	return s
+}
+
+// Subscribe registers fn to be called after every mutation,
+// it's useful for logging and persisting the state.
+func (s *Store) Subscribe(fn Subscriber) *Store {
+	s.subscribers = append(s.subscribers, fn)
+	return s
+}
+
+func payloadOf(payload []interface{}) interface{} {
+	if len(payload) > 0 {
+		return payload[0]
+	}
+	return nil
+}
+
+// Commit runs mutation `name` with the optional payload synchronously
+func (s *Store) Commit(name string, payload ...interface{}) {
+	fn, ok := s.mutations[name]
+	if !ok {
+		panic("store: unknown mutation " + name)
+	}
+	p := payloadOf(payload)
+	fn(s.state, p)
+	for _, sub := range s.subscribers {
+		sub(name, p, s.state)
+	}
+}
+
+// Dispatch runs action `name` with the optional payload in a new goroutine,
+// the returned channel receives the result of the action.
+func (s *Store) Dispatch(name string, payload ...interface{}) <-chan error {
+	fn, ok := s.actions[name]
+	if !ok {
+		panic("store: unknown action " + name)
+	}
+	p := payloadOf(payload)
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(&Context{store: s}, p)
+	}()
+	return done
+}
+
+// Get returns the value of getter `name`
+func (s *Store) Get(name string) interface{} {
+	fn, ok := s.getters[name]
+	if !ok {
+		panic("store: unknown getter " + name)
+	}
+	return fn(s.state)
+}
+
+// MapState adds computed properties to `o` for the state fields whose
+// `js struct tag` are `fields`
+func (s *Store) MapState(o *vue.Option, fields ...string) *vue.Option {
+	obj := s.vm.Get("$data").Get("$$state")
+	for _, field := range fields {
+		field := field
+		o.AddComputed(field, func(vm *vue.ViewModel) interface{} {
+			return obj.Get(field)
+		})
+	}
+	return o
+}
+
+// MapGetters adds computed properties to `o` for getters `names`,
+// all getters are mapped if no name is given.
+func (s *Store) MapGetters(o *vue.Option, names ...string) *vue.Option {
+	if len(names) == 0 {
+		for name := range s.getters {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		fn, ok := s.getters[name]
+		if !ok {
+			panic("store: unknown getter " + name)
+		}
+		o.AddComputed(name, func(vm *vue.ViewModel) interface{} {
+			return fn(s.state)
+		})
+	}
+	return o
+}
+
+// MapMutations adds methods to `o` committing mutations `names`,
+// the first argument of the method is used as the payload, it's decoded
+// by decodePayload so `inc(1)` in templates commits the int 1.
+func (s *Store) MapMutations(o *vue.Option, names ...string) *vue.Option {
+	for _, name := range names {
+		name := name
+		o.AddMethod(name, func(vm *vue.ViewModel, args []*js.Object) {
+			if len(args) > 0 {
+				s.Commit(name, decodePayload(args[0]))
+			} else {
+				s.Commit(name)
+			}
+		})
+	}
+	return o
+}
+
+// decodePayload converts a payload passed in from JavaScript into the Go
+// value a mutation committed from Go would get: integral numbers become
+// int, other numbers float64, strings and booleans their Go types and
+// anything else stays *js.Object.
+func decodePayload(obj *js.Object) interface{} {
+	if obj == nil || obj == js.Undefined {
+		return nil
+	}
+	switch obj.Get("constructor") {
+	case js.Global.Get("Number"):
+		f := obj.Float()
+		if f == float64(int(f)) {
+			return int(f)
+		}
+		return f
+	case js.Global.Get("String"):
+		return obj.String()
+	case js.Global.Get("Boolean"):
+		return obj.Bool()
+	}
+	return obj
+}
@@ -0,0 +1,24 @@
+package vue
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// injectedStyles records the ids of styles already injected
+var injectedStyles = make(map[string]bool, 0)
+
+// InjectStyle appends a `<style>` element with `css` to the document head,
+// styles with the same `id` are injected only once. It's used to register
+// the styles of generated single-file components.
+func InjectStyle(id, css string) {
+	if injectedStyles[id] || css == "" {
+		return
+	}
+	injectedStyles[id] = true
+	doc := js.Global.Get("document")
+	style := doc.Call("createElement", "style")
+	style.Set("type", "text/css")
+	style.Set("id", id)
+	style.Call("appendChild", doc.Call("createTextNode", css))
+	doc.Get("head").Call("appendChild", style)
+}
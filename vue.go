@@ -7,18 +7,18 @@ import (
 )
 
 var (
-	vue  = js.Global.Get("Vue")
-	vMap = make(map[interface{}]*ViewModel, 0)
+	vue = js.Global.Get("Vue")
 )
 
-// Add in the bottom of the array
+// Add in the bottom of the array,
+// see ReactiveSlice for element typed operations.
 func Push(obj *js.Object, any interface{}) (idx int) {
 	return obj.Call("push", any).Int()
 }
 
-// Remove in the bottom of the array
-func Pop(obj *js.Object) (idx int) {
-	return obj.Call("pop").Int()
+// Remove in the bottom of the array, the removed item is returned
+func Pop(obj *js.Object) (item *js.Object) {
+	return obj.Call("pop")
 }
 
 //Add in the front of the array
@@ -26,9 +26,9 @@ func Unshift(obj *js.Object, any interface{}) (idx int) {
 	return obj.Call("unshift", any).Int()
 }
 
-//Remove in the front of the array
-func Shift(obj *js.Object) (idx int) {
-	return obj.Call("shift").Int()
+//Remove in the front of the array, the removed item is returned
+func Shift(obj *js.Object) (item *js.Object) {
+	return obj.Call("shift")
 }
 
 //array slice operation
@@ -117,6 +117,15 @@ type ViewModel struct {
 	// 	Accessing vm.$slots is most useful when writing a component with a render function.
 	Slots *js.Object `js:"$slots"`
 
+	// vm.$scopedSlots
+	// 	Type: { [name: string]: props => VNode | Array<VNode> }
+	// Read only
+	// Details:
+	// 	Used to programmatically access scoped slots.
+	// 	For each slot, including the default one, the object contains
+	// 	a corresponding function that returns VNodes.
+	ScopedSlots *js.Object `js:"$scopedSlots"`
+
 	// vm.$refs
 	// 	Type: Object
 	// 	Read only
@@ -140,7 +149,8 @@ type ViewModel struct {
 	//  deep Boolean optional
 	//  immdediate Boolean optional
 	// Watch an expression on the Vue instance for changes.
-	// The expression can be a single keypath or actual expressions:
+	// The expression can be a single keypath or actual expressions.
+	// See ViewModel.WatchWithOptions for the typed version.
 	WatchEx func(
 		expression string,
 		callback func(newVal, oldVal *js.Object),
@@ -240,6 +250,9 @@ type ViewModel struct {
 //  * all `exported funcs` of the `struct` would become VueJS Instance's
 //  methods which can be called as html event handler: v-on, etc
 //
+//  * `exported funcs` returning (T, error) run in a new goroutine thus may
+//  block, they return a Promise to the JavaScript side instead.
+//
 //  * the `struct` talked above should have an embeded anonymous
 //  `*js.Object` field and `exported fields` should have proper
 //  `js struct tag` for bidirectionaly data bindings
@@ -247,6 +260,12 @@ type ViewModel struct {
 //  * if the `struct` has no embeded anonymous `*js.Object`, it can
 //  only be used for information displaying purpose.
 //
+//  * fields with `vue struct tag` would become props, computed properties
+//  or watchers, see Option.Define for details.
+//
+//  * methods matching the lifecycle hook interfaces like CreatedHook or
+//  MountedHook would be registered as lifecycle hooks instead of methods.
+//
 // Rules for exported functions usage IMPORTANT!:
 //
 //  * If your func uses any of the `exported fields`, then DONOT modify any.
@@ -265,9 +284,17 @@ func New(selectorOrHTMLElement interface{}, structPtr interface{}) *ViewModel {
 	opt := NewOption()
 	opt.El = selectorOrHTMLElement
 	opt.SetDataWithMethods(structPtr)
-	vm := opt.NewViewModel()
-	vMap[structPtr] = vm
-	return vm
+	if hasVueTag(structPtr) {
+		opt.Define(structPtr)
+	}
+	// make GetVM usable in lifecycle hooks
+	opt.OnLifeCycleEvent(EvtBeforeCreate, func(vm *ViewModel) {
+		bindStruct(structPtr, vm)
+	})
+	opt.addLifeCycleHooks(structPtr, func(vm *ViewModel) interface{} {
+		return structPtr
+	})
+	return opt.NewViewModel()
 }
 
 func newViewModel(o *js.Object) *ViewModel {
@@ -280,8 +307,8 @@ func newViewModel(o *js.Object) *ViewModel {
 // (the underlying ViewModel data), this function is mainly in
 // gopherjs struct method functions to reference the `VueJS instance`
 func GetVM(structPtr interface{}) *ViewModel {
-	vm, ok := vMap[structPtr]
-	if !ok {
+	vm, err := LookupVM(structPtr)
+	if err != nil {
 		panic("GetVM: Vue not registerd yet")
 	}
 	return vm
@@ -0,0 +1,186 @@
+package vue
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var viewModelPtrType = reflect.TypeOf((*ViewModel)(nil))
+
+// WatchOptions configs how a watcher is triggered
+type WatchOptions struct {
+	// Deep detects nested value changes inside Objects,
+	// note that you don't need to do so to listen for Array mutations.
+	Deep bool
+	// Immediate triggers the callback immediately with
+	// the current value of the expression.
+	Immediate bool
+	// Sync runs the callback synchronously on every change
+	// instead of being buffered until the next update cycle.
+	Sync bool
+}
+
+func (o *WatchOptions) toJS() js.M {
+	return js.M{
+		"deep":      o.Deep,
+		"immediate": o.Immediate,
+		"sync":      o.Sync,
+	}
+}
+
+func mergeWatchOptions(opts []WatchOptions) *WatchOptions {
+	if len(opts) > 0 {
+		return &opts[0]
+	}
+	return new(WatchOptions)
+}
+
+// makeWatchHandler converts the typed Go `callback` into a VueJS watch
+// handler, the form of `callback` is:
+//
+//	func([vm *ViewModel,] [newVal T, [oldVal T]])
+//
+// where `vm` is only allowed when `withVM` is true and T can be any type
+// supported by Decode.
+func makeWatchHandler(callback interface{}, withVM bool) *js.Object {
+	fn := reflect.ValueOf(callback)
+	t := fn.Type()
+	if t.Kind() != reflect.Func {
+		panic("vue: watch callback must be a func, got " + t.String())
+	}
+	first := 0
+	if withVM && t.NumIn() > 0 && t.In(0) == viewModelPtrType {
+		first = 1
+	}
+	if t.NumIn()-first > 2 {
+		panic("vue: too many arguments for watch callback " + t.String())
+	}
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		in := make([]reflect.Value, t.NumIn())
+		if first > 0 {
+			in[0] = reflect.ValueOf(newViewModel(this))
+		}
+		for i := first; i < t.NumIn(); i++ {
+			var arg *js.Object
+			if i-first < len(arguments) {
+				arg = arguments[i-first]
+			}
+			in[i] = Decode(arg, t.In(i))
+		}
+		fn.Call(in)
+		return nil
+	})
+}
+
+// WatchWithOptions watches `expression` of the VueJS instance for changes
+// with `opts`, `callback` receives the new and old values decoded into
+// its argument types:
+//
+//	vm.WatchWithOptions("count", func(newVal, oldVal int) {
+//		println(oldVal, "=>", newVal)
+//	}, vue.WatchOptions{Immediate: true})
+//
+// The returned function stops the watching.
+func (v *ViewModel) WatchWithOptions(expression string, callback interface{}, opts ...WatchOptions) (unwatcher func()) {
+	obj := v.Call("$watch",
+		expression,
+		makeWatchHandler(callback, false),
+		mergeWatchOptions(opts).toJS(),
+	)
+	return func() {
+		obj.Invoke()
+	}
+}
+
+// Watch adds a watcher using the `watch` option, so every VueJS instance
+// created from the Option would have it. `callback` receives the instance
+// optionally, then the new and old values decoded into its argument types:
+//
+//	opt.Watch("todos", func(vm *vue.ViewModel, todos []*Todo) {
+//		...
+//	}, vue.WatchOptions{Deep: true})
+func (o *Option) Watch(expression string, callback interface{}, opts ...WatchOptions) *Option {
+	conf := mergeWatchOptions(opts).toJS()
+	conf["handler"] = makeWatchHandler(callback, true)
+	return o.addMixin("watch", js.M{
+		expression: conf,
+	})
+}
+
+// Change is a value change of a watched expression
+type Change struct {
+	New *js.Object
+	Old *js.Object
+}
+
+// WatchChanOptions configs ViewModel.WatchChan
+type WatchChanOptions struct {
+	WatchOptions
+	// Coalesce merges changes not received yet into one, whose Old is
+	// the value before the first change and New is the latest value,
+	// so slow receivers only see the latest state.
+	Coalesce bool
+	// Context stops the watching when it's done, optional
+	Context context.Context
+}
+
+// WatchChan watches `expression` of the VueJS instance and delivers the
+// changes on the returned channel, changes are queued without limit unless
+// Coalesce is set:
+//
+//	changes := vm.WatchChan("query", vue.WatchChanOptions{
+//		Coalesce: true,
+//		Context:  ctx,
+//	})
+//	for c := range changes {
+//		search(c.New.String())
+//	}
+//
+// The watching stops and the channel is closed when the instance is
+// destroyed or the optional Context is done.
+func (v *ViewModel) WatchChan(expression string, opts ...WatchChanOptions) <-chan *Change {
+	var opt WatchChanOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	var coalesce func(pending, item interface{}) interface{}
+	if opt.Coalesce {
+		coalesce = func(pending, item interface{}) interface{} {
+			return &Change{
+				New: item.(*Change).New,
+				Old: pending.(*Change).Old,
+			}
+		}
+	}
+	ch := make(chan *Change)
+	q := newQueue(coalesce, func(item interface{}, done <-chan struct{}) bool {
+		select {
+		case ch <- item.(*Change):
+			return true
+		case <-done:
+			return false
+		}
+	}, func() {
+		close(ch)
+	})
+	unwatch := v.WatchWithOptions(expression, func(newVal, oldVal *js.Object) {
+		q.push(&Change{New: newVal, Old: oldVal})
+	}, opt.WatchOptions)
+	stop := func() {
+		unwatch()
+		q.close()
+	}
+	v.onDestroyed(stop)
+	if opt.Context != nil {
+		go func() {
+			select {
+			case <-opt.Context.Done():
+				stop()
+			case <-q.done:
+			}
+		}()
+	}
+	return ch
+}